@@ -2,7 +2,14 @@
 package displayapi
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"hash/crc32"
+	"image"
+	_ "image/gif"  // register the GIF decoder for DrawImageRegion
+	_ "image/jpeg" // register the JPEG decoder for DrawImageRegion
+	"image/png"
 
 	"go.viam.com/utils/protoutils"
 	"go.viam.com/utils/rpc"
@@ -48,11 +55,82 @@ func init() {
 type Display interface {
 	resource.Resource
 	DisplayBytes(ctx context.Context, data []byte) error
+	DisplayBytesRLE(ctx context.Context, encoded []byte) error
+	DisplayBytesDirty(ctx context.Context, data []byte, dirtyX, dirtyY, dirtyW, dirtyH int) error
 	WriteString(ctx context.Context, xloc, yloc int, text string) error
+	WriteStringDouble(ctx context.Context, xloc, yloc int, text string) error
+	WriteStringScaled(ctx context.Context, xloc, yloc, factor int, text string) error
+	WriteStringCursor(ctx context.Context, xloc, yloc int, text string) (int, int, error)
+	WriteStringWrapped(ctx context.Context, xloc, yloc int, text string, maxWidth int) (int, error)
+	WriteStringRotated(ctx context.Context, x, y, angleDeg int, text string) error
+	WriteStringVertical(ctx context.Context, x, y int, text, direction string) error
+	WriteStringAlongArc(ctx context.Context, cx, cy, radius, startDeg int, text string) error
+	WriteStringKnockout(ctx context.Context, barX, barY, barW, barH, textX, textY int, text string) error
+	ScrollText(ctx context.Context, y int, text string, speed int) error
+	StartScroll(ctx context.Context, direction string, startPage, endPage int) error
+	StopScroll(ctx context.Context) error
+	MeasureString(ctx context.Context, text string) (int, int, error)
+	LogLine(ctx context.Context, text string, withTimestamp bool) error
+	DrawPixel(ctx context.Context, x, y int) error
+	ClearPixel(ctx context.Context, x, y int) error
 	DrawLine(ctx context.Context, x1, y1, x2, y2 int) error
+	DrawRect(ctx context.Context, x, y, w, h int) error
+	FillRect(ctx context.Context, x, y, w, h int) error
+	DrawCircle(ctx context.Context, cx, cy, r int) error
+	FillCircle(ctx context.Context, cx, cy, r int) error
+	DrawEllipse(ctx context.Context, cx, cy, rx, ry int) error
+	FillEllipse(ctx context.Context, cx, cy, rx, ry int) error
+	DrawRoundRect(ctx context.Context, x, y, w, h, r int) error
+	FillRoundRect(ctx context.Context, x, y, w, h, r int) error
+	DrawTriangle(ctx context.Context, x0, y0, x1, y1, x2, y2 int) error
+	FillTriangle(ctx context.Context, x0, y0, x1, y1, x2, y2 int) error
+	DrawBorder(ctx context.Context, x, y, w, h int, style string) error
+	DrawGrid(ctx context.Context, x, y, w, h, cols, rows int) error
+	DrawWaterfall(ctx context.Context, x, y, w, h int, column []float64) error
+	DrawImageRegion(ctx context.Context, dstX, dstY int, src image.Image, srcX, srcY, w, h int) error
+	DrawBitmap(ctx context.Context, img image.Image, x, y int) error
+	DrawBitmapDithered(ctx context.Context, img image.Image, x, y int) error
+	DrawBitmapRLE(ctx context.Context, x, y, w, h int, encoded []byte) error
+	DrawProgressBarLabeled(ctx context.Context, x, y, w, h, percent int, label string) error
+	DrawAxes(ctx context.Context, x, y, w, h int, xLabel, yLabel string, ticks int) error
+	DrawDPad(ctx context.Context, cx, cy, size int, pressed string) error
+	DrawKeyValues(ctx context.Context, x, y int, keys, values []string, colGap int) error
+	Batch(ctx context.Context, ops []DrawOp) error
+	DrawTestPattern(ctx context.Context, pattern string) error
+	DrawCalibration(ctx context.Context) error
+	DisplayScaled(ctx context.Context, data []byte, srcW, srcH, factor int) error
+	GetDimensions(ctx context.Context) (int, int, error)
+	GetPixel(ctx context.Context, x, y int) (bool, error)
+	GetRegion(ctx context.Context, x, y, w, h int) ([]byte, error)
+	ReadBuffer(ctx context.Context) ([]byte, error)
+	SetRegion(ctx context.Context, x, y, w, h int, data []byte) error
+	SetScale(ctx context.Context, factor float64) error
+	SetFont(ctx context.Context, name string) error
+	SetContrast(ctx context.Context, level int) error
+	SetInvert(ctx context.Context, invert bool) error
+	SetDisplayOn(ctx context.Context, on bool) error
+	ReadStatus(ctx context.Context) (byte, error)
+	DetectController(ctx context.Context) (string, error)
+	Undo(ctx context.Context) error
+	Redo(ctx context.Context) error
+	Refresh(ctx context.Context) error
+	Clear(ctx context.Context) error
 	Reset(ctx context.Context) error
 }
 
+// DrawOp is one primitive of a Batch. Op picks what's drawn and which of the other fields it uses:
+//   - "pixel" turns on the pixel at (X, Y)
+//   - "line" draws a line from (X, Y) to (X2, Y2)
+//   - "rect" and "fill_rect" draw the outline of, or fill, the W x H rectangle whose bottom left corner is (X, Y)
+//   - "text" writes Text at (X, Y) as WriteString does
+type DrawOp struct {
+	Op     string
+	X, Y   int
+	X2, Y2 int
+	W, H   int
+	Text   string
+}
+
 // serviceServer implements the Display RPC service from display.proto.
 type serviceServer struct {
 	pb.UnimplementedDisplayServiceServer
@@ -69,6 +147,12 @@ func (s *serviceServer) DisplayBytes(ctx context.Context, req *pb.DisplayBytesRe
 	if err != nil {
 		return nil, err
 	}
+	if req.Length != nil && int(*req.Length) != len(req.Data) {
+		return nil, fmt.Errorf("display bytes frame is %d bytes but the request says %d, dropping it", len(req.Data), *req.Length)
+	}
+	if req.Crc32 != nil && crc32.ChecksumIEEE(req.Data) != *req.Crc32 {
+		return nil, fmt.Errorf("display bytes frame failed its CRC-32 check, dropping it")
+	}
 	err = g.DisplayBytes(ctx, req.Data)
 	if err != nil {
 		return nil, err
@@ -76,6 +160,30 @@ func (s *serviceServer) DisplayBytes(ctx context.Context, req *pb.DisplayBytesRe
 	return &pb.DisplayBytesResponse{}, nil
 }
 
+func (s *serviceServer) DisplayBytesRLE(ctx context.Context, req *pb.DisplayBytesRLERequest) (*pb.DisplayBytesRLEResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DisplayBytesRLE(ctx, req.Encoded)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DisplayBytesRLEResponse{}, nil
+}
+
+func (s *serviceServer) DisplayBytesDirty(ctx context.Context, req *pb.DisplayBytesDirtyRequest) (*pb.DisplayBytesDirtyResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DisplayBytesDirty(ctx, req.Data, int(req.DirtyX), int(req.DirtyY), int(req.DirtyW), int(req.DirtyH))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DisplayBytesDirtyResponse{}, nil
+}
+
 func (s *serviceServer) WriteString(ctx context.Context, req *pb.WriteStringRequest) (*pb.WriteStringResponse, error) {
 	g, err := s.coll.Resource(req.Name)
 	if err != nil {
@@ -88,136 +196,1592 @@ func (s *serviceServer) WriteString(ctx context.Context, req *pb.WriteStringRequ
 	return &pb.WriteStringResponse{}, nil
 }
 
-func (s *serviceServer) DrawLine(ctx context.Context, req *pb.DrawLineRequest) (*pb.DrawLineResponse, error) {
+func (s *serviceServer) WriteStringDouble(ctx context.Context, req *pb.WriteStringDoubleRequest) (*pb.WriteStringDoubleResponse, error) {
 	g, err := s.coll.Resource(req.Name)
 	if err != nil {
 		return nil, err
 	}
-	err = g.DrawLine(ctx, int(req.X1), int(req.Y1), int(req.X2), int(req.Y2))
+	err = g.WriteStringDouble(ctx, int(req.X), int(req.Y), req.Text)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.DrawLineResponse{}, nil
+	return &pb.WriteStringDoubleResponse{}, nil
 }
 
-func (s *serviceServer) Reset(ctx context.Context, req *pb.ResetRequest) (*pb.ResetResponse, error) {
+func (s *serviceServer) WriteStringScaled(ctx context.Context, req *pb.WriteStringScaledRequest) (*pb.WriteStringScaledResponse, error) {
 	g, err := s.coll.Resource(req.Name)
 	if err != nil {
 		return nil, err
 	}
-	err = g.Reset(ctx)
+	err = g.WriteStringScaled(ctx, int(req.Xloc), int(req.Yloc), int(req.Factor), req.Text)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.ResetResponse{}, nil
+	return &pb.WriteStringScaledResponse{}, nil
 }
 
-func (s *serviceServer) DoCommand(ctx context.Context, req *pb.DoCommandRequest) (*pb.DoCommandResponse, error) {
+func (s *serviceServer) WriteStringCursor(ctx context.Context, req *pb.WriteStringCursorRequest) (*pb.WriteStringCursorResponse, error) {
 	g, err := s.coll.Resource(req.Name)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := g.DoCommand(ctx, req.Command.AsMap())
+	endX, endY, err := g.WriteStringCursor(ctx, int(req.X), int(req.Y), req.Text)
 	if err != nil {
 		return nil, err
 	}
-	pbResp, err := protoutils.StructToStructPb(resp)
+	return &pb.WriteStringCursorResponse{
+		EndX: int32(endX),
+		EndY: int32(endY),
+	}, nil
+}
+
+func (s *serviceServer) WriteStringWrapped(ctx context.Context, req *pb.WriteStringWrappedRequest) (*pb.WriteStringWrappedResponse, error) {
+	g, err := s.coll.Resource(req.Name)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.DoCommandResponse{Result: pbResp}, nil
+	lines, err := g.WriteStringWrapped(ctx, int(req.Xloc), int(req.Yloc), req.Text, int(req.MaxWidth))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WriteStringWrappedResponse{
+		Lines: int32(lines),
+	}, nil
 }
 
-// NewClientFromConn creates a new gizmo RPC client from an existing connection.
-func NewClientFromConn(conn rpc.ClientConn, remoteName string, name resource.Name, logger logging.Logger) Display {
-	sc := newSvcClientFromConn(conn, remoteName, name, logger)
-	return clientFromSvcClient(sc, name.ShortName())
+func (s *serviceServer) WriteStringRotated(ctx context.Context, req *pb.WriteStringRotatedRequest) (*pb.WriteStringRotatedResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.WriteStringRotated(ctx, int(req.X), int(req.Y), int(req.AngleDeg), req.Text)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WriteStringRotatedResponse{}, nil
 }
 
-func newSvcClientFromConn(conn rpc.ClientConn, remoteName string, name resource.Name, logger logging.Logger) *serviceClient {
-	client := pb.NewDisplayServiceClient(conn)
-	sc := &serviceClient{
-		Named:  name.PrependRemote(remoteName).AsNamed(),
-		client: client,
-		logger: logger,
+func (s *serviceServer) WriteStringVertical(ctx context.Context, req *pb.WriteStringVerticalRequest) (*pb.WriteStringVerticalResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
 	}
-	return sc
+	err = g.WriteStringVertical(ctx, int(req.X), int(req.Y), req.Text, req.Direction)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WriteStringVerticalResponse{}, nil
 }
 
-type serviceClient struct {
-	resource.Named
-	resource.AlwaysRebuild
-	resource.TriviallyCloseable
-	client pb.DisplayServiceClient
-	logger logging.Logger
+func (s *serviceServer) WriteStringAlongArc(ctx context.Context, req *pb.WriteStringAlongArcRequest) (*pb.WriteStringAlongArcResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.WriteStringAlongArc(ctx, int(req.Cx), int(req.Cy), int(req.Radius), int(req.StartDeg), req.Text)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WriteStringAlongArcResponse{}, nil
 }
 
-// client is an gripper client.
-type client struct {
-	*serviceClient
-	name string
+func (s *serviceServer) WriteStringKnockout(
+	ctx context.Context,
+	req *pb.WriteStringKnockoutRequest,
+) (*pb.WriteStringKnockoutResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.WriteStringKnockout(ctx, int(req.BarX), int(req.BarY), int(req.BarW), int(req.BarH),
+		int(req.TextX), int(req.TextY), req.Text)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WriteStringKnockoutResponse{}, nil
 }
 
-func clientFromSvcClient(sc *serviceClient, name string) Display {
-	return &client{sc, name}
+func (s *serviceServer) ScrollText(ctx context.Context, req *pb.ScrollTextRequest) (*pb.ScrollTextResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.ScrollText(ctx, int(req.Y), req.Text, int(req.Speed))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ScrollTextResponse{}, nil
 }
 
-func (c *client) DisplayBytes(ctx context.Context, data []byte) error {
-	_, err := c.client.DisplayBytes(ctx, &pb.DisplayBytesRequest{
-		Name: c.name,
-		Data: data,
-	})
+func (s *serviceServer) StartScroll(ctx context.Context, req *pb.StartScrollRequest) (*pb.StartScrollResponse, error) {
+	g, err := s.coll.Resource(req.Name)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	err = g.StartScroll(ctx, req.Direction, int(req.StartPage), int(req.EndPage))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StartScrollResponse{}, nil
 }
-func (c *client) WriteString(ctx context.Context, xloc, yloc int, text string) error {
-	_, err := c.client.WriteString(ctx, &pb.WriteStringRequest{
-		Name: c.name,
-		Xloc: int32(xloc),
-		Yloc: int32(yloc),
-		Text: text,
-	})
+
+func (s *serviceServer) StopScroll(ctx context.Context, req *pb.StopScrollRequest) (*pb.StopScrollResponse, error) {
+	g, err := s.coll.Resource(req.Name)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	err = g.StopScroll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StopScrollResponse{}, nil
 }
-func (c *client) DrawLine(ctx context.Context, x1, y1, x2, y2 int) error {
-	_, err := c.client.DrawLine(ctx, &pb.DrawLineRequest{
-		Name: c.name,
-		X1: int32(x1),
-		Y1: int32(y1),
-		X2: int32(x2),
-		Y2: int32(y2),
-	})
+
+func (s *serviceServer) MeasureString(ctx context.Context, req *pb.MeasureStringRequest) (*pb.MeasureStringResponse, error) {
+	g, err := s.coll.Resource(req.Name)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	width, height, err := g.MeasureString(ctx, req.Text)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MeasureStringResponse{
+		Width:  int32(width),
+		Height: int32(height),
+	}, nil
 }
-func (c *client) Reset(ctx context.Context) error {
-	_, err := c.client.Reset(ctx, &pb.ResetRequest{
-		Name: c.name,
-	})
+
+func (s *serviceServer) LogLine(ctx context.Context, req *pb.LogLineRequest) (*pb.LogLineResponse, error) {
+	g, err := s.coll.Resource(req.Name)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	err = g.LogLine(ctx, req.Text, req.WithTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LogLineResponse{}, nil
 }
 
-func (c *client) DoCommand(ctx context.Context, cmd map[string]interface{}) (map[string]interface{}, error) {
-	command, err := protoutils.StructToStructPb(cmd)
+func (s *serviceServer) DrawPixel(ctx context.Context, req *pb.DrawPixelRequest) (*pb.DrawPixelResponse, error) {
+	g, err := s.coll.Resource(req.Name)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := c.client.DoCommand(ctx, &pb.DoCommandRequest{
-		Name:    c.name,
-		Command: command,
-	})
+	err = g.DrawPixel(ctx, int(req.X), int(req.Y))
 	if err != nil {
 		return nil, err
 	}
-	return resp.Result.AsMap(), nil
+	return &pb.DrawPixelResponse{}, nil
+}
+
+func (s *serviceServer) ClearPixel(ctx context.Context, req *pb.ClearPixelRequest) (*pb.ClearPixelResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.ClearPixel(ctx, int(req.X), int(req.Y))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ClearPixelResponse{}, nil
+}
+
+func (s *serviceServer) DrawLine(ctx context.Context, req *pb.DrawLineRequest) (*pb.DrawLineResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawLine(ctx, int(req.X1), int(req.Y1), int(req.X2), int(req.Y2))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawLineResponse{}, nil
+}
+
+func (s *serviceServer) DrawRect(ctx context.Context, req *pb.DrawRectRequest) (*pb.DrawRectResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawRect(ctx, int(req.X), int(req.Y), int(req.W), int(req.H))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawRectResponse{}, nil
+}
+
+func (s *serviceServer) FillRect(ctx context.Context, req *pb.FillRectRequest) (*pb.FillRectResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.FillRect(ctx, int(req.X), int(req.Y), int(req.W), int(req.H))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FillRectResponse{}, nil
+}
+
+func (s *serviceServer) DrawCircle(ctx context.Context, req *pb.DrawCircleRequest) (*pb.DrawCircleResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawCircle(ctx, int(req.Cx), int(req.Cy), int(req.R))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawCircleResponse{}, nil
+}
+
+func (s *serviceServer) FillCircle(ctx context.Context, req *pb.FillCircleRequest) (*pb.FillCircleResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.FillCircle(ctx, int(req.Cx), int(req.Cy), int(req.R))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FillCircleResponse{}, nil
+}
+
+func (s *serviceServer) DrawEllipse(ctx context.Context, req *pb.DrawEllipseRequest) (*pb.DrawEllipseResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawEllipse(ctx, int(req.Cx), int(req.Cy), int(req.Rx), int(req.Ry))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawEllipseResponse{}, nil
+}
+
+func (s *serviceServer) FillEllipse(ctx context.Context, req *pb.FillEllipseRequest) (*pb.FillEllipseResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.FillEllipse(ctx, int(req.Cx), int(req.Cy), int(req.Rx), int(req.Ry))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FillEllipseResponse{}, nil
+}
+
+func (s *serviceServer) DrawRoundRect(ctx context.Context, req *pb.DrawRoundRectRequest) (*pb.DrawRoundRectResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawRoundRect(ctx, int(req.X), int(req.Y), int(req.W), int(req.H), int(req.R))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawRoundRectResponse{}, nil
+}
+
+func (s *serviceServer) FillRoundRect(ctx context.Context, req *pb.FillRoundRectRequest) (*pb.FillRoundRectResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.FillRoundRect(ctx, int(req.X), int(req.Y), int(req.W), int(req.H), int(req.R))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FillRoundRectResponse{}, nil
+}
+
+func (s *serviceServer) DrawTriangle(ctx context.Context, req *pb.DrawTriangleRequest) (*pb.DrawTriangleResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawTriangle(ctx, int(req.X0), int(req.Y0), int(req.X1), int(req.Y1), int(req.X2), int(req.Y2))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawTriangleResponse{}, nil
+}
+
+func (s *serviceServer) FillTriangle(ctx context.Context, req *pb.FillTriangleRequest) (*pb.FillTriangleResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.FillTriangle(ctx, int(req.X0), int(req.Y0), int(req.X1), int(req.Y1), int(req.X2), int(req.Y2))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FillTriangleResponse{}, nil
+}
+
+func (s *serviceServer) DrawBorder(ctx context.Context, req *pb.DrawBorderRequest) (*pb.DrawBorderResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawBorder(ctx, int(req.X), int(req.Y), int(req.W), int(req.H), req.Style)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawBorderResponse{}, nil
+}
+
+func (s *serviceServer) DrawGrid(ctx context.Context, req *pb.DrawGridRequest) (*pb.DrawGridResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawGrid(ctx, int(req.X), int(req.Y), int(req.W), int(req.H), int(req.Cols), int(req.Rows))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawGridResponse{}, nil
+}
+
+func (s *serviceServer) DrawWaterfall(ctx context.Context, req *pb.DrawWaterfallRequest) (*pb.DrawWaterfallResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawWaterfall(ctx, int(req.X), int(req.Y), int(req.W), int(req.H), req.Column)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawWaterfallResponse{}, nil
+}
+
+func (s *serviceServer) DrawImageRegion(ctx context.Context, req *pb.DrawImageRegionRequest) (*pb.DrawImageRegionResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	src, _, err := image.Decode(bytes.NewReader(req.Image))
+	if err != nil {
+		return nil, fmt.Errorf("decoding source image: %w", err)
+	}
+	err = g.DrawImageRegion(ctx, int(req.DstX), int(req.DstY), src, int(req.SrcX), int(req.SrcY), int(req.W), int(req.H))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawImageRegionResponse{}, nil
+}
+
+func (s *serviceServer) DrawBitmap(ctx context.Context, req *pb.DrawBitmapRequest) (*pb.DrawBitmapResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(req.Image))
+	if err != nil {
+		return nil, fmt.Errorf("decoding image: %w", err)
+	}
+	err = g.DrawBitmap(ctx, img, int(req.X), int(req.Y))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawBitmapResponse{}, nil
+}
+
+func (s *serviceServer) DrawBitmapDithered(ctx context.Context, req *pb.DrawBitmapDitheredRequest) (*pb.DrawBitmapDitheredResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(req.Image))
+	if err != nil {
+		return nil, fmt.Errorf("decoding image: %w", err)
+	}
+	err = g.DrawBitmapDithered(ctx, img, int(req.X), int(req.Y))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawBitmapDitheredResponse{}, nil
+}
+
+func (s *serviceServer) DrawBitmapRLE(ctx context.Context, req *pb.DrawBitmapRLERequest) (*pb.DrawBitmapRLEResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawBitmapRLE(ctx, int(req.X), int(req.Y), int(req.W), int(req.H), req.Encoded)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawBitmapRLEResponse{}, nil
+}
+
+func (s *serviceServer) DrawProgressBarLabeled(ctx context.Context, req *pb.DrawProgressBarLabeledRequest) (*pb.DrawProgressBarLabeledResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawProgressBarLabeled(ctx, int(req.X), int(req.Y), int(req.W), int(req.H), int(req.Percent), req.Label)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawProgressBarLabeledResponse{}, nil
+}
+
+func (s *serviceServer) DrawAxes(ctx context.Context, req *pb.DrawAxesRequest) (*pb.DrawAxesResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawAxes(ctx, int(req.X), int(req.Y), int(req.W), int(req.H), req.XLabel, req.YLabel, int(req.Ticks))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawAxesResponse{}, nil
+}
+
+func (s *serviceServer) DrawDPad(ctx context.Context, req *pb.DrawDPadRequest) (*pb.DrawDPadResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawDPad(ctx, int(req.Cx), int(req.Cy), int(req.Size), req.Pressed)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawDPadResponse{}, nil
+}
+
+func (s *serviceServer) DrawKeyValues(ctx context.Context, req *pb.DrawKeyValuesRequest) (*pb.DrawKeyValuesResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawKeyValues(ctx, int(req.X), int(req.Y), req.Keys, req.Values, int(req.ColGap))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawKeyValuesResponse{}, nil
+}
+
+func (s *serviceServer) Batch(ctx context.Context, req *pb.BatchRequest) (*pb.BatchResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.Batch(ctx, drawOpsFromProto(req.Ops))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchResponse{}, nil
+}
+
+func (s *serviceServer) DrawTestPattern(ctx context.Context, req *pb.DrawTestPatternRequest) (*pb.DrawTestPatternResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawTestPattern(ctx, req.Pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawTestPatternResponse{}, nil
+}
+
+func (s *serviceServer) DrawCalibration(ctx context.Context, req *pb.DrawCalibrationRequest) (*pb.DrawCalibrationResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DrawCalibration(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DrawCalibrationResponse{}, nil
+}
+
+func (s *serviceServer) DisplayScaled(ctx context.Context, req *pb.DisplayScaledRequest) (*pb.DisplayScaledResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.DisplayScaled(ctx, req.Data, int(req.SrcW), int(req.SrcH), int(req.Factor))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DisplayScaledResponse{}, nil
+}
+
+func (s *serviceServer) GetDimensions(ctx context.Context, req *pb.GetDimensionsRequest) (*pb.GetDimensionsResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	width, height, err := g.GetDimensions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetDimensionsResponse{
+		Width:  int32(width),
+		Height: int32(height),
+	}, nil
+}
+
+func (s *serviceServer) GetPixel(ctx context.Context, req *pb.GetPixelRequest) (*pb.GetPixelResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	on, err := g.GetPixel(ctx, int(req.X), int(req.Y))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetPixelResponse{
+		On: on,
+	}, nil
+}
+
+func (s *serviceServer) GetRegion(ctx context.Context, req *pb.GetRegionRequest) (*pb.GetRegionResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := g.GetRegion(ctx, int(req.X), int(req.Y), int(req.W), int(req.H))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetRegionResponse{
+		Data: data,
+	}, nil
+}
+
+func (s *serviceServer) ReadBuffer(ctx context.Context, req *pb.ReadBufferRequest) (*pb.ReadBufferResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := g.ReadBuffer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReadBufferResponse{
+		Data: data,
+	}, nil
+}
+
+func (s *serviceServer) SetRegion(ctx context.Context, req *pb.SetRegionRequest) (*pb.SetRegionResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.SetRegion(ctx, int(req.X), int(req.Y), int(req.W), int(req.H), req.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetRegionResponse{}, nil
+}
+
+func (s *serviceServer) SetScale(ctx context.Context, req *pb.SetScaleRequest) (*pb.SetScaleResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.SetScale(ctx, req.Factor)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetScaleResponse{}, nil
+}
+
+func (s *serviceServer) SetFont(ctx context.Context, req *pb.SetFontRequest) (*pb.SetFontResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.SetFont(ctx, req.Font)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetFontResponse{}, nil
+}
+
+func (s *serviceServer) SetContrast(ctx context.Context, req *pb.SetContrastRequest) (*pb.SetContrastResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.SetContrast(ctx, int(req.Level))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetContrastResponse{}, nil
+}
+
+func (s *serviceServer) SetInvert(ctx context.Context, req *pb.SetInvertRequest) (*pb.SetInvertResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.SetInvert(ctx, req.Invert)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetInvertResponse{}, nil
+}
+
+func (s *serviceServer) SetDisplayOn(ctx context.Context, req *pb.SetDisplayOnRequest) (*pb.SetDisplayOnResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.SetDisplayOn(ctx, req.On)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetDisplayOnResponse{}, nil
+}
+
+func (s *serviceServer) ReadStatus(ctx context.Context, req *pb.ReadStatusRequest) (*pb.ReadStatusResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	status, err := g.ReadStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReadStatusResponse{
+		Status: uint32(status),
+	}, nil
+}
+
+func (s *serviceServer) DetectController(ctx context.Context, req *pb.DetectControllerRequest) (*pb.DetectControllerResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	controller, err := g.DetectController(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DetectControllerResponse{
+		Controller: controller,
+	}, nil
+}
+
+func (s *serviceServer) Undo(ctx context.Context, req *pb.UndoRequest) (*pb.UndoResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.Undo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UndoResponse{}, nil
+}
+
+func (s *serviceServer) Redo(ctx context.Context, req *pb.RedoRequest) (*pb.RedoResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.Redo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RedoResponse{}, nil
+}
+
+func (s *serviceServer) Refresh(ctx context.Context, req *pb.RefreshRequest) (*pb.RefreshResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.Refresh(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RefreshResponse{}, nil
+}
+
+func (s *serviceServer) Clear(ctx context.Context, req *pb.ClearRequest) (*pb.ClearResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.Clear(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ClearResponse{}, nil
+}
+
+func (s *serviceServer) Reset(ctx context.Context, req *pb.ResetRequest) (*pb.ResetResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = g.Reset(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResetResponse{}, nil
+}
+
+func (s *serviceServer) DoCommand(ctx context.Context, req *pb.DoCommandRequest) (*pb.DoCommandResponse, error) {
+	g, err := s.coll.Resource(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := g.DoCommand(ctx, req.Command.AsMap())
+	if err != nil {
+		return nil, err
+	}
+	pbResp, err := protoutils.StructToStructPb(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DoCommandResponse{Result: pbResp}, nil
+}
+
+// NewClientFromConn creates a new gizmo RPC client from an existing connection.
+func NewClientFromConn(conn rpc.ClientConn, remoteName string, name resource.Name, logger logging.Logger) Display {
+	sc := newSvcClientFromConn(conn, remoteName, name, logger)
+	return clientFromSvcClient(sc, name.ShortName())
+}
+
+func newSvcClientFromConn(conn rpc.ClientConn, remoteName string, name resource.Name, logger logging.Logger) *serviceClient {
+	client := pb.NewDisplayServiceClient(conn)
+	sc := &serviceClient{
+		Named:  name.PrependRemote(remoteName).AsNamed(),
+		client: client,
+		logger: logger,
+	}
+	return sc
+}
+
+type serviceClient struct {
+	resource.Named
+	resource.AlwaysRebuild
+	resource.TriviallyCloseable
+	client pb.DisplayServiceClient
+	logger logging.Logger
+}
+
+// client is an gripper client.
+type client struct {
+	*serviceClient
+	name string
+}
+
+func clientFromSvcClient(sc *serviceClient, name string) Display {
+	return &client{sc, name}
+}
+
+func (c *client) DisplayBytes(ctx context.Context, data []byte) error {
+	length := uint32(len(data))
+	sum := crc32.ChecksumIEEE(data)
+	_, err := c.client.DisplayBytes(ctx, &pb.DisplayBytesRequest{
+		Name:   c.name,
+		Data:   data,
+		Length: &length,
+		Crc32:  &sum,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DisplayBytesRLE(ctx context.Context, encoded []byte) error {
+	_, err := c.client.DisplayBytesRLE(ctx, &pb.DisplayBytesRLERequest{
+		Name:    c.name,
+		Encoded: encoded,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DisplayBytesDirty(ctx context.Context, data []byte, dirtyX, dirtyY, dirtyW, dirtyH int) error {
+	_, err := c.client.DisplayBytesDirty(ctx, &pb.DisplayBytesDirtyRequest{
+		Name:   c.name,
+		Data:   data,
+		DirtyX: int32(dirtyX),
+		DirtyY: int32(dirtyY),
+		DirtyW: int32(dirtyW),
+		DirtyH: int32(dirtyH),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) WriteString(ctx context.Context, xloc, yloc int, text string) error {
+	_, err := c.client.WriteString(ctx, &pb.WriteStringRequest{
+		Name: c.name,
+		Xloc: int32(xloc),
+		Yloc: int32(yloc),
+		Text: text,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) WriteStringDouble(ctx context.Context, xloc, yloc int, text string) error {
+	_, err := c.client.WriteStringDouble(ctx, &pb.WriteStringDoubleRequest{
+		Name: c.name,
+		X:    int32(xloc),
+		Y:    int32(yloc),
+		Text: text,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) WriteStringScaled(ctx context.Context, xloc, yloc, factor int, text string) error {
+	_, err := c.client.WriteStringScaled(ctx, &pb.WriteStringScaledRequest{
+		Name:   c.name,
+		Xloc:   int32(xloc),
+		Yloc:   int32(yloc),
+		Factor: int32(factor),
+		Text:   text,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) WriteStringCursor(ctx context.Context, xloc, yloc int, text string) (int, int, error) {
+	resp, err := c.client.WriteStringCursor(ctx, &pb.WriteStringCursorRequest{
+		Name: c.name,
+		X:    int32(xloc),
+		Y:    int32(yloc),
+		Text: text,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(resp.EndX), int(resp.EndY), nil
+}
+func (c *client) WriteStringWrapped(ctx context.Context, xloc, yloc int, text string, maxWidth int) (int, error) {
+	resp, err := c.client.WriteStringWrapped(ctx, &pb.WriteStringWrappedRequest{
+		Name:     c.name,
+		Xloc:     int32(xloc),
+		Yloc:     int32(yloc),
+		Text:     text,
+		MaxWidth: int32(maxWidth),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(resp.Lines), nil
+}
+func (c *client) WriteStringRotated(ctx context.Context, x, y, angleDeg int, text string) error {
+	_, err := c.client.WriteStringRotated(ctx, &pb.WriteStringRotatedRequest{
+		Name:     c.name,
+		X:        int32(x),
+		Y:        int32(y),
+		AngleDeg: int32(angleDeg),
+		Text:     text,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) WriteStringVertical(ctx context.Context, x, y int, text, direction string) error {
+	_, err := c.client.WriteStringVertical(ctx, &pb.WriteStringVerticalRequest{
+		Name:      c.name,
+		X:         int32(x),
+		Y:         int32(y),
+		Text:      text,
+		Direction: direction,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) WriteStringAlongArc(ctx context.Context, cx, cy, radius, startDeg int, text string) error {
+	_, err := c.client.WriteStringAlongArc(ctx, &pb.WriteStringAlongArcRequest{
+		Name:     c.name,
+		Cx:       int32(cx),
+		Cy:       int32(cy),
+		Radius:   int32(radius),
+		StartDeg: int32(startDeg),
+		Text:     text,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) WriteStringKnockout(ctx context.Context, barX, barY, barW, barH, textX, textY int, text string) error {
+	_, err := c.client.WriteStringKnockout(ctx, &pb.WriteStringKnockoutRequest{
+		Name:  c.name,
+		BarX:  int32(barX),
+		BarY:  int32(barY),
+		BarW:  int32(barW),
+		BarH:  int32(barH),
+		TextX: int32(textX),
+		TextY: int32(textY),
+		Text:  text,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) ScrollText(ctx context.Context, y int, text string, speed int) error {
+	_, err := c.client.ScrollText(ctx, &pb.ScrollTextRequest{
+		Name:  c.name,
+		Y:     int32(y),
+		Text:  text,
+		Speed: int32(speed),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) StartScroll(ctx context.Context, direction string, startPage, endPage int) error {
+	_, err := c.client.StartScroll(ctx, &pb.StartScrollRequest{
+		Name:      c.name,
+		Direction: direction,
+		StartPage: int32(startPage),
+		EndPage:   int32(endPage),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) StopScroll(ctx context.Context) error {
+	_, err := c.client.StopScroll(ctx, &pb.StopScrollRequest{
+		Name: c.name,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) MeasureString(ctx context.Context, text string) (int, int, error) {
+	resp, err := c.client.MeasureString(ctx, &pb.MeasureStringRequest{
+		Name: c.name,
+		Text: text,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(resp.Width), int(resp.Height), nil
+}
+func (c *client) LogLine(ctx context.Context, text string, withTimestamp bool) error {
+	_, err := c.client.LogLine(ctx, &pb.LogLineRequest{
+		Name:          c.name,
+		Text:          text,
+		WithTimestamp: withTimestamp,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawPixel(ctx context.Context, x, y int) error {
+	_, err := c.client.DrawPixel(ctx, &pb.DrawPixelRequest{
+		Name: c.name,
+		X:    int32(x),
+		Y:    int32(y),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) ClearPixel(ctx context.Context, x, y int) error {
+	_, err := c.client.ClearPixel(ctx, &pb.ClearPixelRequest{
+		Name: c.name,
+		X:    int32(x),
+		Y:    int32(y),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawLine(ctx context.Context, x1, y1, x2, y2 int) error {
+	_, err := c.client.DrawLine(ctx, &pb.DrawLineRequest{
+		Name: c.name,
+		X1: int32(x1),
+		Y1: int32(y1),
+		X2: int32(x2),
+		Y2: int32(y2),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawRect(ctx context.Context, x, y, w, h int) error {
+	_, err := c.client.DrawRect(ctx, &pb.DrawRectRequest{
+		Name: c.name,
+		X:    int32(x),
+		Y:    int32(y),
+		W:    int32(w),
+		H:    int32(h),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) FillRect(ctx context.Context, x, y, w, h int) error {
+	_, err := c.client.FillRect(ctx, &pb.FillRectRequest{
+		Name: c.name,
+		X:    int32(x),
+		Y:    int32(y),
+		W:    int32(w),
+		H:    int32(h),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawCircle(ctx context.Context, cx, cy, r int) error {
+	_, err := c.client.DrawCircle(ctx, &pb.DrawCircleRequest{
+		Name: c.name,
+		Cx:   int32(cx),
+		Cy:   int32(cy),
+		R:    int32(r),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) FillCircle(ctx context.Context, cx, cy, r int) error {
+	_, err := c.client.FillCircle(ctx, &pb.FillCircleRequest{
+		Name: c.name,
+		Cx:   int32(cx),
+		Cy:   int32(cy),
+		R:    int32(r),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawEllipse(ctx context.Context, cx, cy, rx, ry int) error {
+	_, err := c.client.DrawEllipse(ctx, &pb.DrawEllipseRequest{
+		Name: c.name,
+		Cx:   int32(cx),
+		Cy:   int32(cy),
+		Rx:   int32(rx),
+		Ry:   int32(ry),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) FillEllipse(ctx context.Context, cx, cy, rx, ry int) error {
+	_, err := c.client.FillEllipse(ctx, &pb.FillEllipseRequest{
+		Name: c.name,
+		Cx:   int32(cx),
+		Cy:   int32(cy),
+		Rx:   int32(rx),
+		Ry:   int32(ry),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawRoundRect(ctx context.Context, x, y, w, h, r int) error {
+	_, err := c.client.DrawRoundRect(ctx, &pb.DrawRoundRectRequest{
+		Name: c.name,
+		X:    int32(x),
+		Y:    int32(y),
+		W:    int32(w),
+		H:    int32(h),
+		R:    int32(r),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) FillRoundRect(ctx context.Context, x, y, w, h, r int) error {
+	_, err := c.client.FillRoundRect(ctx, &pb.FillRoundRectRequest{
+		Name: c.name,
+		X:    int32(x),
+		Y:    int32(y),
+		W:    int32(w),
+		H:    int32(h),
+		R:    int32(r),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawTriangle(ctx context.Context, x0, y0, x1, y1, x2, y2 int) error {
+	_, err := c.client.DrawTriangle(ctx, &pb.DrawTriangleRequest{
+		Name: c.name,
+		X0:   int32(x0),
+		Y0:   int32(y0),
+		X1:   int32(x1),
+		Y1:   int32(y1),
+		X2:   int32(x2),
+		Y2:   int32(y2),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) FillTriangle(ctx context.Context, x0, y0, x1, y1, x2, y2 int) error {
+	_, err := c.client.FillTriangle(ctx, &pb.FillTriangleRequest{
+		Name: c.name,
+		X0:   int32(x0),
+		Y0:   int32(y0),
+		X1:   int32(x1),
+		Y1:   int32(y1),
+		X2:   int32(x2),
+		Y2:   int32(y2),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawBorder(ctx context.Context, x, y, w, h int, style string) error {
+	_, err := c.client.DrawBorder(ctx, &pb.DrawBorderRequest{
+		Name:  c.name,
+		X:     int32(x),
+		Y:     int32(y),
+		W:     int32(w),
+		H:     int32(h),
+		Style: style,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawGrid(ctx context.Context, x, y, w, h, cols, rows int) error {
+	_, err := c.client.DrawGrid(ctx, &pb.DrawGridRequest{
+		Name: c.name,
+		X:    int32(x),
+		Y:    int32(y),
+		W:    int32(w),
+		H:    int32(h),
+		Cols: int32(cols),
+		Rows: int32(rows),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawWaterfall(ctx context.Context, x, y, w, h int, column []float64) error {
+	_, err := c.client.DrawWaterfall(ctx, &pb.DrawWaterfallRequest{
+		Name:   c.name,
+		X:      int32(x),
+		Y:      int32(y),
+		W:      int32(w),
+		H:      int32(h),
+		Column: column,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawImageRegion(ctx context.Context, dstX, dstY int, src image.Image, srcX, srcY, w, h int) error {
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, src); err != nil {
+		return err
+	}
+	_, err := c.client.DrawImageRegion(ctx, &pb.DrawImageRegionRequest{
+		Name:  c.name,
+		DstX:  int32(dstX),
+		DstY:  int32(dstY),
+		Image: encoded.Bytes(),
+		SrcX:  int32(srcX),
+		SrcY:  int32(srcY),
+		W:     int32(w),
+		H:     int32(h),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawBitmap(ctx context.Context, img image.Image, x, y int) error {
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, img); err != nil {
+		return err
+	}
+	_, err := c.client.DrawBitmap(ctx, &pb.DrawBitmapRequest{
+		Name:  c.name,
+		Image: encoded.Bytes(),
+		X:     int32(x),
+		Y:     int32(y),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawBitmapDithered(ctx context.Context, img image.Image, x, y int) error {
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, img); err != nil {
+		return err
+	}
+	_, err := c.client.DrawBitmapDithered(ctx, &pb.DrawBitmapDitheredRequest{
+		Name:  c.name,
+		Image: encoded.Bytes(),
+		X:     int32(x),
+		Y:     int32(y),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawBitmapRLE(ctx context.Context, x, y, w, h int, encoded []byte) error {
+	_, err := c.client.DrawBitmapRLE(ctx, &pb.DrawBitmapRLERequest{
+		Name:    c.name,
+		X:       int32(x),
+		Y:       int32(y),
+		W:       int32(w),
+		H:       int32(h),
+		Encoded: encoded,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawProgressBarLabeled(ctx context.Context, x, y, w, h, percent int, label string) error {
+	_, err := c.client.DrawProgressBarLabeled(ctx, &pb.DrawProgressBarLabeledRequest{
+		Name:    c.name,
+		X:       int32(x),
+		Y:       int32(y),
+		W:       int32(w),
+		H:       int32(h),
+		Percent: int32(percent),
+		Label:   label,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawAxes(ctx context.Context, x, y, w, h int, xLabel, yLabel string, ticks int) error {
+	_, err := c.client.DrawAxes(ctx, &pb.DrawAxesRequest{
+		Name:   c.name,
+		X:      int32(x),
+		Y:      int32(y),
+		W:      int32(w),
+		H:      int32(h),
+		XLabel: xLabel,
+		YLabel: yLabel,
+		Ticks:  int32(ticks),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawDPad(ctx context.Context, cx, cy, size int, pressed string) error {
+	_, err := c.client.DrawDPad(ctx, &pb.DrawDPadRequest{
+		Name:    c.name,
+		Cx:      int32(cx),
+		Cy:      int32(cy),
+		Size:    int32(size),
+		Pressed: pressed,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawKeyValues(ctx context.Context, x, y int, keys, values []string, colGap int) error {
+	_, err := c.client.DrawKeyValues(ctx, &pb.DrawKeyValuesRequest{
+		Name:   c.name,
+		X:      int32(x),
+		Y:      int32(y),
+		Keys:   keys,
+		Values: values,
+		ColGap: int32(colGap),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) Batch(ctx context.Context, ops []DrawOp) error {
+	_, err := c.client.Batch(ctx, &pb.BatchRequest{
+		Name: c.name,
+		Ops:  drawOpsToProto(ops),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawTestPattern(ctx context.Context, pattern string) error {
+	_, err := c.client.DrawTestPattern(ctx, &pb.DrawTestPatternRequest{
+		Name:    c.name,
+		Pattern: pattern,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DrawCalibration(ctx context.Context) error {
+	_, err := c.client.DrawCalibration(ctx, &pb.DrawCalibrationRequest{
+		Name: c.name,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) DisplayScaled(ctx context.Context, data []byte, srcW, srcH, factor int) error {
+	_, err := c.client.DisplayScaled(ctx, &pb.DisplayScaledRequest{
+		Name:   c.name,
+		Data:   data,
+		SrcW:   int32(srcW),
+		SrcH:   int32(srcH),
+		Factor: int32(factor),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) GetDimensions(ctx context.Context) (int, int, error) {
+	resp, err := c.client.GetDimensions(ctx, &pb.GetDimensionsRequest{
+		Name: c.name,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(resp.Width), int(resp.Height), nil
+}
+func (c *client) GetPixel(ctx context.Context, x, y int) (bool, error) {
+	resp, err := c.client.GetPixel(ctx, &pb.GetPixelRequest{
+		Name: c.name,
+		X:    int32(x),
+		Y:    int32(y),
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.On, nil
+}
+func (c *client) GetRegion(ctx context.Context, x, y, w, h int) ([]byte, error) {
+	resp, err := c.client.GetRegion(ctx, &pb.GetRegionRequest{
+		Name: c.name,
+		X:    int32(x),
+		Y:    int32(y),
+		W:    int32(w),
+		H:    int32(h),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+func (c *client) ReadBuffer(ctx context.Context) ([]byte, error) {
+	resp, err := c.client.ReadBuffer(ctx, &pb.ReadBufferRequest{
+		Name: c.name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+func (c *client) SetRegion(ctx context.Context, x, y, w, h int, data []byte) error {
+	_, err := c.client.SetRegion(ctx, &pb.SetRegionRequest{
+		Name: c.name,
+		X:    int32(x),
+		Y:    int32(y),
+		W:    int32(w),
+		H:    int32(h),
+		Data: data,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) SetScale(ctx context.Context, factor float64) error {
+	_, err := c.client.SetScale(ctx, &pb.SetScaleRequest{
+		Name:   c.name,
+		Factor: factor,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) SetFont(ctx context.Context, name string) error {
+	_, err := c.client.SetFont(ctx, &pb.SetFontRequest{
+		Name: c.name,
+		Font: name,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) SetContrast(ctx context.Context, level int) error {
+	_, err := c.client.SetContrast(ctx, &pb.SetContrastRequest{
+		Name:  c.name,
+		Level: int32(level),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) SetInvert(ctx context.Context, invert bool) error {
+	_, err := c.client.SetInvert(ctx, &pb.SetInvertRequest{
+		Name:   c.name,
+		Invert: invert,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) SetDisplayOn(ctx context.Context, on bool) error {
+	_, err := c.client.SetDisplayOn(ctx, &pb.SetDisplayOnRequest{
+		Name: c.name,
+		On:   on,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) ReadStatus(ctx context.Context) (byte, error) {
+	resp, err := c.client.ReadStatus(ctx, &pb.ReadStatusRequest{
+		Name: c.name,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return byte(resp.Status), nil
+}
+func (c *client) DetectController(ctx context.Context) (string, error) {
+	resp, err := c.client.DetectController(ctx, &pb.DetectControllerRequest{
+		Name: c.name,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.Controller, nil
+}
+func (c *client) Undo(ctx context.Context) error {
+	_, err := c.client.Undo(ctx, &pb.UndoRequest{
+		Name: c.name,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) Redo(ctx context.Context) error {
+	_, err := c.client.Redo(ctx, &pb.RedoRequest{
+		Name: c.name,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) Refresh(ctx context.Context) error {
+	_, err := c.client.Refresh(ctx, &pb.RefreshRequest{
+		Name: c.name,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) Clear(ctx context.Context) error {
+	_, err := c.client.Clear(ctx, &pb.ClearRequest{
+		Name: c.name,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *client) Reset(ctx context.Context) error {
+	_, err := c.client.Reset(ctx, &pb.ResetRequest{
+		Name: c.name,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) DoCommand(ctx context.Context, cmd map[string]interface{}) (map[string]interface{}, error) {
+	command, err := protoutils.StructToStructPb(cmd)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.DoCommand(ctx, &pb.DoCommandRequest{
+		Name:    c.name,
+		Command: command,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Result.AsMap(), nil
+}
+
+func drawOpsToProto(ops []DrawOp) []*pb.DrawOp {
+	pbOps := make([]*pb.DrawOp, len(ops))
+	for i, op := range ops {
+		pbOps[i] = &pb.DrawOp{
+			Op:   op.Op,
+			X:    int32(op.X),
+			Y:    int32(op.Y),
+			X2:   int32(op.X2),
+			Y2:   int32(op.Y2),
+			W:    int32(op.W),
+			H:    int32(op.H),
+			Text: op.Text,
+		}
+	}
+	return pbOps
+}
+
+func drawOpsFromProto(pbOps []*pb.DrawOp) []DrawOp {
+	ops := make([]DrawOp, len(pbOps))
+	for i, op := range pbOps {
+		ops[i] = DrawOp{
+			Op:   op.Op,
+			X:    int(op.X),
+			Y:    int(op.Y),
+			X2:   int(op.X2),
+			Y2:   int(op.Y2),
+			W:    int(op.W),
+			H:    int(op.H),
+			Text: op.Text,
+		}
+	}
+	return ops
 }
@@ -0,0 +1,49 @@
+package displayapi
+
+import (
+	"context"
+	"hash/crc32"
+	"testing"
+
+	"go.viam.com/rdk/resource"
+	"go.viam.com/test"
+
+	pb "github.com/biotinker/viam-i2c-display/display/api/proto/component/display/v1"
+)
+
+// fakeDisplay records the frames handed to DisplayBytes; every other method is left to the embedded nil Display.
+type fakeDisplay struct {
+	Display
+	frames [][]byte
+}
+
+func (f *fakeDisplay) DisplayBytes(ctx context.Context, data []byte) error {
+	f.frames = append(f.frames, data)
+	return nil
+}
+
+func TestDisplayBytesCRC(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeDisplay{}
+	coll, err := resource.NewAPIResourceCollection(API, map[resource.Name]Display{Named("test"): fake})
+	test.That(t, err, test.ShouldBeNil)
+	server := NewRPCServiceServer(coll).(pb.DisplayServiceServer)
+
+	data := []byte{0x00, 0x18, 0x3C, 0xFF}
+	good := crc32.ChecksumIEEE(data)
+	bad := good ^ 1
+
+	_, err = server.DisplayBytes(ctx, &pb.DisplayBytesRequest{Name: "test", Data: data, Crc32: &bad})
+	test.That(t, err, test.ShouldNotBeNil)
+	test.That(t, err.Error(), test.ShouldContainSubstring, "CRC-32")
+	test.That(t, fake.frames, test.ShouldBeEmpty)
+
+	_, err = server.DisplayBytes(ctx, &pb.DisplayBytesRequest{Name: "test", Data: data, Crc32: &good})
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, fake.frames, test.ShouldResemble, [][]byte{data})
+
+	// the checksum is optional, for callers that predate it
+	_, err = server.DisplayBytes(ctx, &pb.DisplayBytesRequest{Name: "test", Data: data})
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, fake.frames, test.ShouldHaveLength, 2)
+}
@@ -29,6 +29,10 @@ type DisplayBytesRequest struct {
 
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// When set, the server rejects the frame unless data has this many bytes.
+	Length *uint32 `protobuf:"varint,3,opt,name=length,proto3,oneof" json:"length,omitempty"`
+	// When set, the server rejects the frame unless data has this IEEE CRC-32.
+	Crc32 *uint32 `protobuf:"varint,4,opt,name=crc32,proto3,oneof" json:"crc32,omitempty"`
 }
 
 func (x *DisplayBytesRequest) Reset() {
@@ -77,6 +81,20 @@ func (x *DisplayBytesRequest) GetData() []byte {
 	return nil
 }
 
+func (x *DisplayBytesRequest) GetLength() uint32 {
+	if x != nil && x.Length != nil {
+		return *x.Length
+	}
+	return 0
+}
+
+func (x *DisplayBytesRequest) GetCrc32() uint32 {
+	if x != nil && x.Crc32 != nil {
+		return *x.Crc32
+	}
+	return 0
+}
+
 type DisplayBytesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -115,19 +133,17 @@ func (*DisplayBytesResponse) Descriptor() ([]byte, []int) {
 	return file_component_display_v1_display_proto_rawDescGZIP(), []int{1}
 }
 
-type WriteStringRequest struct {
+type DisplayBytesRLERequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Xloc int32  `protobuf:"varint,2,opt,name=xloc,proto3" json:"xloc,omitempty"`
-	Yloc int32  `protobuf:"varint,3,opt,name=yloc,proto3" json:"yloc,omitempty"`
-	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Encoded []byte `protobuf:"bytes,2,opt,name=encoded,proto3" json:"encoded,omitempty"`
 }
 
-func (x *WriteStringRequest) Reset() {
-	*x = WriteStringRequest{}
+func (x *DisplayBytesRLERequest) Reset() {
+	*x = DisplayBytesRLERequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_component_display_v1_display_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -135,13 +151,13 @@ func (x *WriteStringRequest) Reset() {
 	}
 }
 
-func (x *WriteStringRequest) String() string {
+func (x *DisplayBytesRLERequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteStringRequest) ProtoMessage() {}
+func (*DisplayBytesRLERequest) ProtoMessage() {}
 
-func (x *WriteStringRequest) ProtoReflect() protoreflect.Message {
+func (x *DisplayBytesRLERequest) ProtoReflect() protoreflect.Message {
 	mi := &file_component_display_v1_display_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -153,47 +169,33 @@ func (x *WriteStringRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteStringRequest.ProtoReflect.Descriptor instead.
-func (*WriteStringRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisplayBytesRLERequest.ProtoReflect.Descriptor instead.
+func (*DisplayBytesRLERequest) Descriptor() ([]byte, []int) {
 	return file_component_display_v1_display_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *WriteStringRequest) GetName() string {
+func (x *DisplayBytesRLERequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *WriteStringRequest) GetXloc() int32 {
-	if x != nil {
-		return x.Xloc
-	}
-	return 0
-}
-
-func (x *WriteStringRequest) GetYloc() int32 {
-	if x != nil {
-		return x.Yloc
-	}
-	return 0
-}
-
-func (x *WriteStringRequest) GetText() string {
+func (x *DisplayBytesRLERequest) GetEncoded() []byte {
 	if x != nil {
-		return x.Text
+		return x.Encoded
 	}
-	return ""
+	return nil
 }
 
-type WriteStringResponse struct {
+type DisplayBytesRLEResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *WriteStringResponse) Reset() {
-	*x = WriteStringResponse{}
+func (x *DisplayBytesRLEResponse) Reset() {
+	*x = DisplayBytesRLEResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_component_display_v1_display_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -201,13 +203,13 @@ func (x *WriteStringResponse) Reset() {
 	}
 }
 
-func (x *WriteStringResponse) String() string {
+func (x *DisplayBytesRLEResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteStringResponse) ProtoMessage() {}
+func (*DisplayBytesRLEResponse) ProtoMessage() {}
 
-func (x *WriteStringResponse) ProtoReflect() protoreflect.Message {
+func (x *DisplayBytesRLEResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_component_display_v1_display_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -219,25 +221,26 @@ func (x *WriteStringResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteStringResponse.ProtoReflect.Descriptor instead.
-func (*WriteStringResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisplayBytesRLEResponse.ProtoReflect.Descriptor instead.
+func (*DisplayBytesRLEResponse) Descriptor() ([]byte, []int) {
 	return file_component_display_v1_display_proto_rawDescGZIP(), []int{3}
 }
 
-type DrawLineRequest struct {
+type DisplayBytesDirtyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	X1   int32  `protobuf:"varint,2,opt,name=x1,proto3" json:"x1,omitempty"`
-	Y1   int32  `protobuf:"varint,3,opt,name=y1,proto3" json:"y1,omitempty"`
-	X2   int32  `protobuf:"varint,4,opt,name=x2,proto3" json:"x2,omitempty"`
-	Y2   int32  `protobuf:"varint,5,opt,name=y2,proto3" json:"y2,omitempty"`
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data   []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	DirtyX int32  `protobuf:"varint,3,opt,name=dirty_x,json=dirtyX,proto3" json:"dirty_x,omitempty"`
+	DirtyY int32  `protobuf:"varint,4,opt,name=dirty_y,json=dirtyY,proto3" json:"dirty_y,omitempty"`
+	DirtyW int32  `protobuf:"varint,5,opt,name=dirty_w,json=dirtyW,proto3" json:"dirty_w,omitempty"`
+	DirtyH int32  `protobuf:"varint,6,opt,name=dirty_h,json=dirtyH,proto3" json:"dirty_h,omitempty"`
 }
 
-func (x *DrawLineRequest) Reset() {
-	*x = DrawLineRequest{}
+func (x *DisplayBytesDirtyRequest) Reset() {
+	*x = DisplayBytesDirtyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_component_display_v1_display_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -245,13 +248,13 @@ func (x *DrawLineRequest) Reset() {
 	}
 }
 
-func (x *DrawLineRequest) String() string {
+func (x *DisplayBytesDirtyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DrawLineRequest) ProtoMessage() {}
+func (*DisplayBytesDirtyRequest) ProtoMessage() {}
 
-func (x *DrawLineRequest) ProtoReflect() protoreflect.Message {
+func (x *DisplayBytesDirtyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_component_display_v1_display_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -263,54 +266,61 @@ func (x *DrawLineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DrawLineRequest.ProtoReflect.Descriptor instead.
-func (*DrawLineRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisplayBytesDirtyRequest.ProtoReflect.Descriptor instead.
+func (*DisplayBytesDirtyRequest) Descriptor() ([]byte, []int) {
 	return file_component_display_v1_display_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DrawLineRequest) GetName() string {
+func (x *DisplayBytesDirtyRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *DrawLineRequest) GetX1() int32 {
+func (x *DisplayBytesDirtyRequest) GetData() []byte {
 	if x != nil {
-		return x.X1
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DisplayBytesDirtyRequest) GetDirtyX() int32 {
+	if x != nil {
+		return x.DirtyX
 	}
 	return 0
 }
 
-func (x *DrawLineRequest) GetY1() int32 {
+func (x *DisplayBytesDirtyRequest) GetDirtyY() int32 {
 	if x != nil {
-		return x.Y1
+		return x.DirtyY
 	}
 	return 0
 }
 
-func (x *DrawLineRequest) GetX2() int32 {
+func (x *DisplayBytesDirtyRequest) GetDirtyW() int32 {
 	if x != nil {
-		return x.X2
+		return x.DirtyW
 	}
 	return 0
 }
 
-func (x *DrawLineRequest) GetY2() int32 {
+func (x *DisplayBytesDirtyRequest) GetDirtyH() int32 {
 	if x != nil {
-		return x.Y2
+		return x.DirtyH
 	}
 	return 0
 }
 
-type DrawLineResponse struct {
+type DisplayBytesDirtyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DrawLineResponse) Reset() {
-	*x = DrawLineResponse{}
+func (x *DisplayBytesDirtyResponse) Reset() {
+	*x = DisplayBytesDirtyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_component_display_v1_display_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -318,13 +328,13 @@ func (x *DrawLineResponse) Reset() {
 	}
 }
 
-func (x *DrawLineResponse) String() string {
+func (x *DisplayBytesDirtyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DrawLineResponse) ProtoMessage() {}
+func (*DisplayBytesDirtyResponse) ProtoMessage() {}
 
-func (x *DrawLineResponse) ProtoReflect() protoreflect.Message {
+func (x *DisplayBytesDirtyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_component_display_v1_display_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -336,21 +346,24 @@ func (x *DrawLineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DrawLineResponse.ProtoReflect.Descriptor instead.
-func (*DrawLineResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisplayBytesDirtyResponse.ProtoReflect.Descriptor instead.
+func (*DisplayBytesDirtyResponse) Descriptor() ([]byte, []int) {
 	return file_component_display_v1_display_proto_rawDescGZIP(), []int{5}
 }
 
-type ResetRequest struct {
+type WriteStringRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Xloc int32  `protobuf:"varint,2,opt,name=xloc,proto3" json:"xloc,omitempty"`
+	Yloc int32  `protobuf:"varint,3,opt,name=yloc,proto3" json:"yloc,omitempty"`
+	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *ResetRequest) Reset() {
-	*x = ResetRequest{}
+func (x *WriteStringRequest) Reset() {
+	*x = WriteStringRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_component_display_v1_display_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -358,13 +371,13 @@ func (x *ResetRequest) Reset() {
 	}
 }
 
-func (x *ResetRequest) String() string {
+func (x *WriteStringRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetRequest) ProtoMessage() {}
+func (*WriteStringRequest) ProtoMessage() {}
 
-func (x *ResetRequest) ProtoReflect() protoreflect.Message {
+func (x *WriteStringRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_component_display_v1_display_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -376,26 +389,47 @@ func (x *ResetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetRequest.ProtoReflect.Descriptor instead.
-func (*ResetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WriteStringRequest.ProtoReflect.Descriptor instead.
+func (*WriteStringRequest) Descriptor() ([]byte, []int) {
 	return file_component_display_v1_display_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ResetRequest) GetName() string {
+func (x *WriteStringRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type ResetResponse struct {
+func (x *WriteStringRequest) GetXloc() int32 {
+	if x != nil {
+		return x.Xloc
+	}
+	return 0
+}
+
+func (x *WriteStringRequest) GetYloc() int32 {
+	if x != nil {
+		return x.Yloc
+	}
+	return 0
+}
+
+func (x *WriteStringRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type WriteStringResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ResetResponse) Reset() {
-	*x = ResetResponse{}
+func (x *WriteStringResponse) Reset() {
+	*x = WriteStringResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_component_display_v1_display_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -403,13 +437,13 @@ func (x *ResetResponse) Reset() {
 	}
 }
 
-func (x *ResetResponse) String() string {
+func (x *WriteStringResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetResponse) ProtoMessage() {}
+func (*WriteStringResponse) ProtoMessage() {}
 
-func (x *ResetResponse) ProtoReflect() protoreflect.Message {
+func (x *WriteStringResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_component_display_v1_display_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -421,22 +455,24 @@ func (x *ResetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetResponse.ProtoReflect.Descriptor instead.
-func (*ResetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WriteStringResponse.ProtoReflect.Descriptor instead.
+func (*WriteStringResponse) Descriptor() ([]byte, []int) {
 	return file_component_display_v1_display_proto_rawDescGZIP(), []int{7}
 }
 
-type DoCommandRequest struct {
+type WriteStringDoubleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Command *structpb.Struct `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X    int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y    int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *DoCommandRequest) Reset() {
-	*x = DoCommandRequest{}
+func (x *WriteStringDoubleRequest) Reset() {
+	*x = WriteStringDoubleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_component_display_v1_display_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -444,13 +480,13 @@ func (x *DoCommandRequest) Reset() {
 	}
 }
 
-func (x *DoCommandRequest) String() string {
+func (x *WriteStringDoubleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DoCommandRequest) ProtoMessage() {}
+func (*WriteStringDoubleRequest) ProtoMessage() {}
 
-func (x *DoCommandRequest) ProtoReflect() protoreflect.Message {
+func (x *WriteStringDoubleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_component_display_v1_display_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -462,35 +498,47 @@ func (x *DoCommandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DoCommandRequest.ProtoReflect.Descriptor instead.
-func (*DoCommandRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WriteStringDoubleRequest.ProtoReflect.Descriptor instead.
+func (*WriteStringDoubleRequest) Descriptor() ([]byte, []int) {
 	return file_component_display_v1_display_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DoCommandRequest) GetName() string {
+func (x *WriteStringDoubleRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *DoCommandRequest) GetCommand() *structpb.Struct {
+func (x *WriteStringDoubleRequest) GetX() int32 {
 	if x != nil {
-		return x.Command
+		return x.X
 	}
-	return nil
+	return 0
 }
 
-type DoCommandResponse struct {
+func (x *WriteStringDoubleRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *WriteStringDoubleRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type WriteStringDoubleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Result *structpb.Struct `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *DoCommandResponse) Reset() {
-	*x = DoCommandResponse{}
+func (x *WriteStringDoubleResponse) Reset() {
+	*x = WriteStringDoubleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_component_display_v1_display_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -498,13 +546,13 @@ func (x *DoCommandResponse) Reset() {
 	}
 }
 
-func (x *DoCommandResponse) String() string {
+func (x *WriteStringDoubleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DoCommandResponse) ProtoMessage() {}
+func (*WriteStringDoubleResponse) ProtoMessage() {}
 
-func (x *DoCommandResponse) ProtoReflect() protoreflect.Message {
+func (x *WriteStringDoubleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_component_display_v1_display_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -516,182 +564,9199 @@ func (x *DoCommandResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DoCommandResponse.ProtoReflect.Descriptor instead.
-func (*DoCommandResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WriteStringDoubleResponse.ProtoReflect.Descriptor instead.
+func (*WriteStringDoubleResponse) Descriptor() ([]byte, []int) {
 	return file_component_display_v1_display_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DoCommandResponse) GetResult() *structpb.Struct {
-	if x != nil {
-		return x.Result
-	}
-	return nil
+type WriteStringScaledRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Xloc   int32  `protobuf:"varint,2,opt,name=xloc,proto3" json:"xloc,omitempty"`
+	Yloc   int32  `protobuf:"varint,3,opt,name=yloc,proto3" json:"yloc,omitempty"`
+	Factor int32  `protobuf:"varint,4,opt,name=factor,proto3" json:"factor,omitempty"`
+	Text   string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-var File_component_display_v1_display_proto protoreflect.FileDescriptor
+func (x *WriteStringScaledRequest) Reset() {
+	*x = WriteStringScaledRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-var file_component_display_v1_display_proto_rawDesc = []byte{
-	0x0a, 0x22, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70,
-	0x6c, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x3d, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x16, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x12, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x78, 0x6c, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x78, 0x6c, 0x6f, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x6c, 0x6f, 0x63, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x6c, 0x6f, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x15, 0x0a,
-	0x13, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x0f, 0x44, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x78,
-	0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x78, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x79,
-	0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x79, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x78,
-	0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x78, 0x32, 0x12, 0x0e, 0x0a, 0x02, 0x79,
-	0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x79, 0x32, 0x22, 0x12, 0x0a, 0x10, 0x44,
-	0x72, 0x61, 0x77, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x22, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x07,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22,
-	0x44, 0x0a, 0x11, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x89, 0x07, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xbb, 0x01, 0x0a, 0x0c, 0x44, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x62, 0x69, 0x6f, 0x74,
-	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
-	0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x22, 0x38, 0x2f, 0x62,
-	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0xb7, 0x01, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b,
-	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x62, 0x69, 0x6f,
-	0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x22, 0x37, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
-	0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x12, 0xab, 0x01, 0x0a, 0x08, 0x44, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x2f, 0x2e,
-	0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x72, 0x61, 0x77, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
-	0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x22, 0x34, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69,
-	0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x9e,
-	0x01, 0x0a, 0x05, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69,
-	0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b,
-	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22, 0x30, 0x2f,
-	0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12,
-	0xaf, 0x01, 0x0a, 0x09, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x30, 0x2e,
-	0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x31, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x22, 0x35, 0x2f, 0x62, 0x69, 0x6f,
-	0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2d, 0x69,
-	0x32, 0x63, 0x2d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x76,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *WriteStringScaledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var (
-	file_component_display_v1_display_proto_rawDescOnce sync.Once
-	file_component_display_v1_display_proto_rawDescData = file_component_display_v1_display_proto_rawDesc
-)
+func (*WriteStringScaledRequest) ProtoMessage() {}
 
-func file_component_display_v1_display_proto_rawDescGZIP() []byte {
-	file_component_display_v1_display_proto_rawDescOnce.Do(func() {
-		file_component_display_v1_display_proto_rawDescData = protoimpl.X.CompressGZIP(file_component_display_v1_display_proto_rawDescData)
-	})
-	return file_component_display_v1_display_proto_rawDescData
+func (x *WriteStringScaledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_component_display_v1_display_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_component_display_v1_display_proto_goTypes = []interface{}{
-	(*DisplayBytesRequest)(nil),  // 0: biotinker.component.display.v1.DisplayBytesRequest
-	(*DisplayBytesResponse)(nil), // 1: biotinker.component.display.v1.DisplayBytesResponse
-	(*WriteStringRequest)(nil),   // 2: biotinker.component.display.v1.WriteStringRequest
-	(*WriteStringResponse)(nil),  // 3: biotinker.component.display.v1.WriteStringResponse
-	(*DrawLineRequest)(nil),      // 4: biotinker.component.display.v1.DrawLineRequest
-	(*DrawLineResponse)(nil),     // 5: biotinker.component.display.v1.DrawLineResponse
-	(*ResetRequest)(nil),         // 6: biotinker.component.display.v1.ResetRequest
-	(*ResetResponse)(nil),        // 7: biotinker.component.display.v1.ResetResponse
-	(*DoCommandRequest)(nil),     // 8: biotinker.component.display.v1.DoCommandRequest
-	(*DoCommandResponse)(nil),    // 9: biotinker.component.display.v1.DoCommandResponse
-	(*structpb.Struct)(nil),      // 10: google.protobuf.Struct
-}
-var file_component_display_v1_display_proto_depIdxs = []int32{
-	10, // 0: biotinker.component.display.v1.DoCommandRequest.command:type_name -> google.protobuf.Struct
-	10, // 1: biotinker.component.display.v1.DoCommandResponse.result:type_name -> google.protobuf.Struct
-	0,  // 2: biotinker.component.display.v1.DisplayService.DisplayBytes:input_type -> biotinker.component.display.v1.DisplayBytesRequest
-	2,  // 3: biotinker.component.display.v1.DisplayService.WriteString:input_type -> biotinker.component.display.v1.WriteStringRequest
-	4,  // 4: biotinker.component.display.v1.DisplayService.DrawLine:input_type -> biotinker.component.display.v1.DrawLineRequest
-	6,  // 5: biotinker.component.display.v1.DisplayService.Reset:input_type -> biotinker.component.display.v1.ResetRequest
-	8,  // 6: biotinker.component.display.v1.DisplayService.DoCommand:input_type -> biotinker.component.display.v1.DoCommandRequest
-	1,  // 7: biotinker.component.display.v1.DisplayService.DisplayBytes:output_type -> biotinker.component.display.v1.DisplayBytesResponse
-	3,  // 8: biotinker.component.display.v1.DisplayService.WriteString:output_type -> biotinker.component.display.v1.WriteStringResponse
-	5,  // 9: biotinker.component.display.v1.DisplayService.DrawLine:output_type -> biotinker.component.display.v1.DrawLineResponse
-	7,  // 10: biotinker.component.display.v1.DisplayService.Reset:output_type -> biotinker.component.display.v1.ResetResponse
-	9,  // 11: biotinker.component.display.v1.DisplayService.DoCommand:output_type -> biotinker.component.display.v1.DoCommandResponse
-	7,  // [7:12] is the sub-list for method output_type
-	2,  // [2:7] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+// Deprecated: Use WriteStringScaledRequest.ProtoReflect.Descriptor instead.
+func (*WriteStringScaledRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{10}
 }
 
-func init() { file_component_display_v1_display_proto_init() }
-func file_component_display_v1_display_proto_init() {
-	if File_component_display_v1_display_proto != nil {
-		return
+func (x *WriteStringScaledRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_component_display_v1_display_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisplayBytesRequest); i {
+	return ""
+}
+
+func (x *WriteStringScaledRequest) GetXloc() int32 {
+	if x != nil {
+		return x.Xloc
+	}
+	return 0
+}
+
+func (x *WriteStringScaledRequest) GetYloc() int32 {
+	if x != nil {
+		return x.Yloc
+	}
+	return 0
+}
+
+func (x *WriteStringScaledRequest) GetFactor() int32 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+func (x *WriteStringScaledRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type WriteStringScaledResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WriteStringScaledResponse) Reset() {
+	*x = WriteStringScaledResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteStringScaledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteStringScaledResponse) ProtoMessage() {}
+
+func (x *WriteStringScaledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteStringScaledResponse.ProtoReflect.Descriptor instead.
+func (*WriteStringScaledResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{11}
+}
+
+type WriteStringCursorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X    int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y    int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *WriteStringCursorRequest) Reset() {
+	*x = WriteStringCursorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteStringCursorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteStringCursorRequest) ProtoMessage() {}
+
+func (x *WriteStringCursorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteStringCursorRequest.ProtoReflect.Descriptor instead.
+func (*WriteStringCursorRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WriteStringCursorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WriteStringCursorRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *WriteStringCursorRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *WriteStringCursorRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type WriteStringCursorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndX int32 `protobuf:"varint,1,opt,name=end_x,json=endX,proto3" json:"end_x,omitempty"`
+	EndY int32 `protobuf:"varint,2,opt,name=end_y,json=endY,proto3" json:"end_y,omitempty"`
+}
+
+func (x *WriteStringCursorResponse) Reset() {
+	*x = WriteStringCursorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteStringCursorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteStringCursorResponse) ProtoMessage() {}
+
+func (x *WriteStringCursorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteStringCursorResponse.ProtoReflect.Descriptor instead.
+func (*WriteStringCursorResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WriteStringCursorResponse) GetEndX() int32 {
+	if x != nil {
+		return x.EndX
+	}
+	return 0
+}
+
+func (x *WriteStringCursorResponse) GetEndY() int32 {
+	if x != nil {
+		return x.EndY
+	}
+	return 0
+}
+
+type WriteStringWrappedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Xloc     int32  `protobuf:"varint,2,opt,name=xloc,proto3" json:"xloc,omitempty"`
+	Yloc     int32  `protobuf:"varint,3,opt,name=yloc,proto3" json:"yloc,omitempty"`
+	Text     string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	MaxWidth int32  `protobuf:"varint,5,opt,name=max_width,json=maxWidth,proto3" json:"max_width,omitempty"`
+}
+
+func (x *WriteStringWrappedRequest) Reset() {
+	*x = WriteStringWrappedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteStringWrappedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteStringWrappedRequest) ProtoMessage() {}
+
+func (x *WriteStringWrappedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteStringWrappedRequest.ProtoReflect.Descriptor instead.
+func (*WriteStringWrappedRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WriteStringWrappedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WriteStringWrappedRequest) GetXloc() int32 {
+	if x != nil {
+		return x.Xloc
+	}
+	return 0
+}
+
+func (x *WriteStringWrappedRequest) GetYloc() int32 {
+	if x != nil {
+		return x.Yloc
+	}
+	return 0
+}
+
+func (x *WriteStringWrappedRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *WriteStringWrappedRequest) GetMaxWidth() int32 {
+	if x != nil {
+		return x.MaxWidth
+	}
+	return 0
+}
+
+type WriteStringWrappedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lines int32 `protobuf:"varint,1,opt,name=lines,proto3" json:"lines,omitempty"`
+}
+
+func (x *WriteStringWrappedResponse) Reset() {
+	*x = WriteStringWrappedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteStringWrappedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteStringWrappedResponse) ProtoMessage() {}
+
+func (x *WriteStringWrappedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteStringWrappedResponse.ProtoReflect.Descriptor instead.
+func (*WriteStringWrappedResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WriteStringWrappedResponse) GetLines() int32 {
+	if x != nil {
+		return x.Lines
+	}
+	return 0
+}
+
+type WriteStringRotatedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X        int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y        int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	AngleDeg int32  `protobuf:"varint,4,opt,name=angle_deg,json=angleDeg,proto3" json:"angle_deg,omitempty"`
+	Text     string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *WriteStringRotatedRequest) Reset() {
+	*x = WriteStringRotatedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteStringRotatedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteStringRotatedRequest) ProtoMessage() {}
+
+func (x *WriteStringRotatedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteStringRotatedRequest.ProtoReflect.Descriptor instead.
+func (*WriteStringRotatedRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WriteStringRotatedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WriteStringRotatedRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *WriteStringRotatedRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *WriteStringRotatedRequest) GetAngleDeg() int32 {
+	if x != nil {
+		return x.AngleDeg
+	}
+	return 0
+}
+
+func (x *WriteStringRotatedRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type WriteStringRotatedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WriteStringRotatedResponse) Reset() {
+	*x = WriteStringRotatedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteStringRotatedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteStringRotatedResponse) ProtoMessage() {}
+
+func (x *WriteStringRotatedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteStringRotatedResponse.ProtoReflect.Descriptor instead.
+func (*WriteStringRotatedResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{17}
+}
+
+type WriteStringVerticalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X         int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y         int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	Text      string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Direction string `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+}
+
+func (x *WriteStringVerticalRequest) Reset() {
+	*x = WriteStringVerticalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteStringVerticalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteStringVerticalRequest) ProtoMessage() {}
+
+func (x *WriteStringVerticalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteStringVerticalRequest.ProtoReflect.Descriptor instead.
+func (*WriteStringVerticalRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WriteStringVerticalRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WriteStringVerticalRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *WriteStringVerticalRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *WriteStringVerticalRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *WriteStringVerticalRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+type WriteStringVerticalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WriteStringVerticalResponse) Reset() {
+	*x = WriteStringVerticalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteStringVerticalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteStringVerticalResponse) ProtoMessage() {}
+
+func (x *WriteStringVerticalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteStringVerticalResponse.ProtoReflect.Descriptor instead.
+func (*WriteStringVerticalResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{19}
+}
+
+type WriteStringAlongArcRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cx       int32  `protobuf:"varint,2,opt,name=cx,proto3" json:"cx,omitempty"`
+	Cy       int32  `protobuf:"varint,3,opt,name=cy,proto3" json:"cy,omitempty"`
+	Radius   int32  `protobuf:"varint,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	StartDeg int32  `protobuf:"varint,5,opt,name=start_deg,json=startDeg,proto3" json:"start_deg,omitempty"`
+	Text     string `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *WriteStringAlongArcRequest) Reset() {
+	*x = WriteStringAlongArcRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteStringAlongArcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteStringAlongArcRequest) ProtoMessage() {}
+
+func (x *WriteStringAlongArcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteStringAlongArcRequest.ProtoReflect.Descriptor instead.
+func (*WriteStringAlongArcRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WriteStringAlongArcRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WriteStringAlongArcRequest) GetCx() int32 {
+	if x != nil {
+		return x.Cx
+	}
+	return 0
+}
+
+func (x *WriteStringAlongArcRequest) GetCy() int32 {
+	if x != nil {
+		return x.Cy
+	}
+	return 0
+}
+
+func (x *WriteStringAlongArcRequest) GetRadius() int32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *WriteStringAlongArcRequest) GetStartDeg() int32 {
+	if x != nil {
+		return x.StartDeg
+	}
+	return 0
+}
+
+func (x *WriteStringAlongArcRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type WriteStringAlongArcResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WriteStringAlongArcResponse) Reset() {
+	*x = WriteStringAlongArcResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteStringAlongArcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteStringAlongArcResponse) ProtoMessage() {}
+
+func (x *WriteStringAlongArcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteStringAlongArcResponse.ProtoReflect.Descriptor instead.
+func (*WriteStringAlongArcResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{21}
+}
+
+type WriteStringKnockoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BarX  int32  `protobuf:"varint,2,opt,name=bar_x,json=barX,proto3" json:"bar_x,omitempty"`
+	BarY  int32  `protobuf:"varint,3,opt,name=bar_y,json=barY,proto3" json:"bar_y,omitempty"`
+	BarW  int32  `protobuf:"varint,4,opt,name=bar_w,json=barW,proto3" json:"bar_w,omitempty"`
+	BarH  int32  `protobuf:"varint,5,opt,name=bar_h,json=barH,proto3" json:"bar_h,omitempty"`
+	TextX int32  `protobuf:"varint,6,opt,name=text_x,json=textX,proto3" json:"text_x,omitempty"`
+	TextY int32  `protobuf:"varint,7,opt,name=text_y,json=textY,proto3" json:"text_y,omitempty"`
+	Text  string `protobuf:"bytes,8,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *WriteStringKnockoutRequest) Reset() {
+	*x = WriteStringKnockoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteStringKnockoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteStringKnockoutRequest) ProtoMessage() {}
+
+func (x *WriteStringKnockoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteStringKnockoutRequest.ProtoReflect.Descriptor instead.
+func (*WriteStringKnockoutRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WriteStringKnockoutRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WriteStringKnockoutRequest) GetBarX() int32 {
+	if x != nil {
+		return x.BarX
+	}
+	return 0
+}
+
+func (x *WriteStringKnockoutRequest) GetBarY() int32 {
+	if x != nil {
+		return x.BarY
+	}
+	return 0
+}
+
+func (x *WriteStringKnockoutRequest) GetBarW() int32 {
+	if x != nil {
+		return x.BarW
+	}
+	return 0
+}
+
+func (x *WriteStringKnockoutRequest) GetBarH() int32 {
+	if x != nil {
+		return x.BarH
+	}
+	return 0
+}
+
+func (x *WriteStringKnockoutRequest) GetTextX() int32 {
+	if x != nil {
+		return x.TextX
+	}
+	return 0
+}
+
+func (x *WriteStringKnockoutRequest) GetTextY() int32 {
+	if x != nil {
+		return x.TextY
+	}
+	return 0
+}
+
+func (x *WriteStringKnockoutRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type WriteStringKnockoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WriteStringKnockoutResponse) Reset() {
+	*x = WriteStringKnockoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteStringKnockoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteStringKnockoutResponse) ProtoMessage() {}
+
+func (x *WriteStringKnockoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteStringKnockoutResponse.ProtoReflect.Descriptor instead.
+func (*WriteStringKnockoutResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{23}
+}
+
+type ScrollTextRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Y     int32  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Text  string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Speed int32  `protobuf:"varint,4,opt,name=speed,proto3" json:"speed,omitempty"`
+}
+
+func (x *ScrollTextRequest) Reset() {
+	*x = ScrollTextRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScrollTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScrollTextRequest) ProtoMessage() {}
+
+func (x *ScrollTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScrollTextRequest.ProtoReflect.Descriptor instead.
+func (*ScrollTextRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ScrollTextRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScrollTextRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ScrollTextRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ScrollTextRequest) GetSpeed() int32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+type ScrollTextResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ScrollTextResponse) Reset() {
+	*x = ScrollTextResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScrollTextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScrollTextResponse) ProtoMessage() {}
+
+func (x *ScrollTextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScrollTextResponse.ProtoReflect.Descriptor instead.
+func (*ScrollTextResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{25}
+}
+
+type StartScrollRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Direction string `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	StartPage int32  `protobuf:"varint,3,opt,name=start_page,json=startPage,proto3" json:"start_page,omitempty"`
+	EndPage   int32  `protobuf:"varint,4,opt,name=end_page,json=endPage,proto3" json:"end_page,omitempty"`
+}
+
+func (x *StartScrollRequest) Reset() {
+	*x = StartScrollRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartScrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartScrollRequest) ProtoMessage() {}
+
+func (x *StartScrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartScrollRequest.ProtoReflect.Descriptor instead.
+func (*StartScrollRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StartScrollRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartScrollRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *StartScrollRequest) GetStartPage() int32 {
+	if x != nil {
+		return x.StartPage
+	}
+	return 0
+}
+
+func (x *StartScrollRequest) GetEndPage() int32 {
+	if x != nil {
+		return x.EndPage
+	}
+	return 0
+}
+
+type StartScrollResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartScrollResponse) Reset() {
+	*x = StartScrollResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartScrollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartScrollResponse) ProtoMessage() {}
+
+func (x *StartScrollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartScrollResponse.ProtoReflect.Descriptor instead.
+func (*StartScrollResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{27}
+}
+
+type StopScrollRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *StopScrollRequest) Reset() {
+	*x = StopScrollRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopScrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopScrollRequest) ProtoMessage() {}
+
+func (x *StopScrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopScrollRequest.ProtoReflect.Descriptor instead.
+func (*StopScrollRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StopScrollRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StopScrollResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopScrollResponse) Reset() {
+	*x = StopScrollResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopScrollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopScrollResponse) ProtoMessage() {}
+
+func (x *StopScrollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopScrollResponse.ProtoReflect.Descriptor instead.
+func (*StopScrollResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{29}
+}
+
+type MeasureStringRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *MeasureStringRequest) Reset() {
+	*x = MeasureStringRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeasureStringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeasureStringRequest) ProtoMessage() {}
+
+func (x *MeasureStringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeasureStringRequest.ProtoReflect.Descriptor instead.
+func (*MeasureStringRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MeasureStringRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MeasureStringRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type MeasureStringResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Width  int32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *MeasureStringResponse) Reset() {
+	*x = MeasureStringResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeasureStringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeasureStringResponse) ProtoMessage() {}
+
+func (x *MeasureStringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeasureStringResponse.ProtoReflect.Descriptor instead.
+func (*MeasureStringResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MeasureStringResponse) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *MeasureStringResponse) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type LogLineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Text          string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	WithTimestamp bool   `protobuf:"varint,3,opt,name=with_timestamp,json=withTimestamp,proto3" json:"with_timestamp,omitempty"`
+}
+
+func (x *LogLineRequest) Reset() {
+	*x = LogLineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLineRequest) ProtoMessage() {}
+
+func (x *LogLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLineRequest.ProtoReflect.Descriptor instead.
+func (*LogLineRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LogLineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LogLineRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *LogLineRequest) GetWithTimestamp() bool {
+	if x != nil {
+		return x.WithTimestamp
+	}
+	return false
+}
+
+type LogLineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LogLineResponse) Reset() {
+	*x = LogLineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLineResponse) ProtoMessage() {}
+
+func (x *LogLineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLineResponse.ProtoReflect.Descriptor instead.
+func (*LogLineResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{33}
+}
+
+type DrawPixelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X    int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y    int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *DrawPixelRequest) Reset() {
+	*x = DrawPixelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawPixelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawPixelRequest) ProtoMessage() {}
+
+func (x *DrawPixelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawPixelRequest.ProtoReflect.Descriptor instead.
+func (*DrawPixelRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DrawPixelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawPixelRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DrawPixelRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type DrawPixelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawPixelResponse) Reset() {
+	*x = DrawPixelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawPixelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawPixelResponse) ProtoMessage() {}
+
+func (x *DrawPixelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawPixelResponse.ProtoReflect.Descriptor instead.
+func (*DrawPixelResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{35}
+}
+
+type ClearPixelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X    int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y    int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *ClearPixelRequest) Reset() {
+	*x = ClearPixelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearPixelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearPixelRequest) ProtoMessage() {}
+
+func (x *ClearPixelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearPixelRequest.ProtoReflect.Descriptor instead.
+func (*ClearPixelRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ClearPixelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClearPixelRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ClearPixelRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type ClearPixelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearPixelResponse) Reset() {
+	*x = ClearPixelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearPixelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearPixelResponse) ProtoMessage() {}
+
+func (x *ClearPixelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearPixelResponse.ProtoReflect.Descriptor instead.
+func (*ClearPixelResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{37}
+}
+
+type DrawLineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X1   int32  `protobuf:"varint,2,opt,name=x1,proto3" json:"x1,omitempty"`
+	Y1   int32  `protobuf:"varint,3,opt,name=y1,proto3" json:"y1,omitempty"`
+	X2   int32  `protobuf:"varint,4,opt,name=x2,proto3" json:"x2,omitempty"`
+	Y2   int32  `protobuf:"varint,5,opt,name=y2,proto3" json:"y2,omitempty"`
+}
+
+func (x *DrawLineRequest) Reset() {
+	*x = DrawLineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawLineRequest) ProtoMessage() {}
+
+func (x *DrawLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawLineRequest.ProtoReflect.Descriptor instead.
+func (*DrawLineRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DrawLineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawLineRequest) GetX1() int32 {
+	if x != nil {
+		return x.X1
+	}
+	return 0
+}
+
+func (x *DrawLineRequest) GetY1() int32 {
+	if x != nil {
+		return x.Y1
+	}
+	return 0
+}
+
+func (x *DrawLineRequest) GetX2() int32 {
+	if x != nil {
+		return x.X2
+	}
+	return 0
+}
+
+func (x *DrawLineRequest) GetY2() int32 {
+	if x != nil {
+		return x.Y2
+	}
+	return 0
+}
+
+type DrawLineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawLineResponse) Reset() {
+	*x = DrawLineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawLineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawLineResponse) ProtoMessage() {}
+
+func (x *DrawLineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawLineResponse.ProtoReflect.Descriptor instead.
+func (*DrawLineResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{39}
+}
+
+type DrawRectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X    int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y    int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	W    int32  `protobuf:"varint,4,opt,name=w,proto3" json:"w,omitempty"`
+	H    int32  `protobuf:"varint,5,opt,name=h,proto3" json:"h,omitempty"`
+}
+
+func (x *DrawRectRequest) Reset() {
+	*x = DrawRectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawRectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawRectRequest) ProtoMessage() {}
+
+func (x *DrawRectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawRectRequest.ProtoReflect.Descriptor instead.
+func (*DrawRectRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DrawRectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawRectRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DrawRectRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *DrawRectRequest) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *DrawRectRequest) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+type DrawRectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawRectResponse) Reset() {
+	*x = DrawRectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawRectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawRectResponse) ProtoMessage() {}
+
+func (x *DrawRectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawRectResponse.ProtoReflect.Descriptor instead.
+func (*DrawRectResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{41}
+}
+
+type FillRectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X    int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y    int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	W    int32  `protobuf:"varint,4,opt,name=w,proto3" json:"w,omitempty"`
+	H    int32  `protobuf:"varint,5,opt,name=h,proto3" json:"h,omitempty"`
+}
+
+func (x *FillRectRequest) Reset() {
+	*x = FillRectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillRectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillRectRequest) ProtoMessage() {}
+
+func (x *FillRectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillRectRequest.ProtoReflect.Descriptor instead.
+func (*FillRectRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FillRectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FillRectRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *FillRectRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *FillRectRequest) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *FillRectRequest) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+type FillRectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FillRectResponse) Reset() {
+	*x = FillRectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillRectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillRectResponse) ProtoMessage() {}
+
+func (x *FillRectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillRectResponse.ProtoReflect.Descriptor instead.
+func (*FillRectResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{43}
+}
+
+type DrawCircleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cx   int32  `protobuf:"varint,2,opt,name=cx,proto3" json:"cx,omitempty"`
+	Cy   int32  `protobuf:"varint,3,opt,name=cy,proto3" json:"cy,omitempty"`
+	R    int32  `protobuf:"varint,4,opt,name=r,proto3" json:"r,omitempty"`
+}
+
+func (x *DrawCircleRequest) Reset() {
+	*x = DrawCircleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawCircleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawCircleRequest) ProtoMessage() {}
+
+func (x *DrawCircleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawCircleRequest.ProtoReflect.Descriptor instead.
+func (*DrawCircleRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DrawCircleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawCircleRequest) GetCx() int32 {
+	if x != nil {
+		return x.Cx
+	}
+	return 0
+}
+
+func (x *DrawCircleRequest) GetCy() int32 {
+	if x != nil {
+		return x.Cy
+	}
+	return 0
+}
+
+func (x *DrawCircleRequest) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+type DrawCircleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawCircleResponse) Reset() {
+	*x = DrawCircleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawCircleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawCircleResponse) ProtoMessage() {}
+
+func (x *DrawCircleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawCircleResponse.ProtoReflect.Descriptor instead.
+func (*DrawCircleResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{45}
+}
+
+type FillCircleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cx   int32  `protobuf:"varint,2,opt,name=cx,proto3" json:"cx,omitempty"`
+	Cy   int32  `protobuf:"varint,3,opt,name=cy,proto3" json:"cy,omitempty"`
+	R    int32  `protobuf:"varint,4,opt,name=r,proto3" json:"r,omitempty"`
+}
+
+func (x *FillCircleRequest) Reset() {
+	*x = FillCircleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillCircleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillCircleRequest) ProtoMessage() {}
+
+func (x *FillCircleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillCircleRequest.ProtoReflect.Descriptor instead.
+func (*FillCircleRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *FillCircleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FillCircleRequest) GetCx() int32 {
+	if x != nil {
+		return x.Cx
+	}
+	return 0
+}
+
+func (x *FillCircleRequest) GetCy() int32 {
+	if x != nil {
+		return x.Cy
+	}
+	return 0
+}
+
+func (x *FillCircleRequest) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+type FillCircleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FillCircleResponse) Reset() {
+	*x = FillCircleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillCircleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillCircleResponse) ProtoMessage() {}
+
+func (x *FillCircleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillCircleResponse.ProtoReflect.Descriptor instead.
+func (*FillCircleResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{47}
+}
+
+type DrawEllipseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cx   int32  `protobuf:"varint,2,opt,name=cx,proto3" json:"cx,omitempty"`
+	Cy   int32  `protobuf:"varint,3,opt,name=cy,proto3" json:"cy,omitempty"`
+	Rx   int32  `protobuf:"varint,4,opt,name=rx,proto3" json:"rx,omitempty"`
+	Ry   int32  `protobuf:"varint,5,opt,name=ry,proto3" json:"ry,omitempty"`
+}
+
+func (x *DrawEllipseRequest) Reset() {
+	*x = DrawEllipseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawEllipseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawEllipseRequest) ProtoMessage() {}
+
+func (x *DrawEllipseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawEllipseRequest.ProtoReflect.Descriptor instead.
+func (*DrawEllipseRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DrawEllipseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawEllipseRequest) GetCx() int32 {
+	if x != nil {
+		return x.Cx
+	}
+	return 0
+}
+
+func (x *DrawEllipseRequest) GetCy() int32 {
+	if x != nil {
+		return x.Cy
+	}
+	return 0
+}
+
+func (x *DrawEllipseRequest) GetRx() int32 {
+	if x != nil {
+		return x.Rx
+	}
+	return 0
+}
+
+func (x *DrawEllipseRequest) GetRy() int32 {
+	if x != nil {
+		return x.Ry
+	}
+	return 0
+}
+
+type DrawEllipseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawEllipseResponse) Reset() {
+	*x = DrawEllipseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawEllipseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawEllipseResponse) ProtoMessage() {}
+
+func (x *DrawEllipseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawEllipseResponse.ProtoReflect.Descriptor instead.
+func (*DrawEllipseResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{49}
+}
+
+type FillEllipseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cx   int32  `protobuf:"varint,2,opt,name=cx,proto3" json:"cx,omitempty"`
+	Cy   int32  `protobuf:"varint,3,opt,name=cy,proto3" json:"cy,omitempty"`
+	Rx   int32  `protobuf:"varint,4,opt,name=rx,proto3" json:"rx,omitempty"`
+	Ry   int32  `protobuf:"varint,5,opt,name=ry,proto3" json:"ry,omitempty"`
+}
+
+func (x *FillEllipseRequest) Reset() {
+	*x = FillEllipseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillEllipseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillEllipseRequest) ProtoMessage() {}
+
+func (x *FillEllipseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillEllipseRequest.ProtoReflect.Descriptor instead.
+func (*FillEllipseRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *FillEllipseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FillEllipseRequest) GetCx() int32 {
+	if x != nil {
+		return x.Cx
+	}
+	return 0
+}
+
+func (x *FillEllipseRequest) GetCy() int32 {
+	if x != nil {
+		return x.Cy
+	}
+	return 0
+}
+
+func (x *FillEllipseRequest) GetRx() int32 {
+	if x != nil {
+		return x.Rx
+	}
+	return 0
+}
+
+func (x *FillEllipseRequest) GetRy() int32 {
+	if x != nil {
+		return x.Ry
+	}
+	return 0
+}
+
+type FillEllipseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FillEllipseResponse) Reset() {
+	*x = FillEllipseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillEllipseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillEllipseResponse) ProtoMessage() {}
+
+func (x *FillEllipseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillEllipseResponse.ProtoReflect.Descriptor instead.
+func (*FillEllipseResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{51}
+}
+
+type DrawRoundRectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X    int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y    int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	W    int32  `protobuf:"varint,4,opt,name=w,proto3" json:"w,omitempty"`
+	H    int32  `protobuf:"varint,5,opt,name=h,proto3" json:"h,omitempty"`
+	R    int32  `protobuf:"varint,6,opt,name=r,proto3" json:"r,omitempty"`
+}
+
+func (x *DrawRoundRectRequest) Reset() {
+	*x = DrawRoundRectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawRoundRectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawRoundRectRequest) ProtoMessage() {}
+
+func (x *DrawRoundRectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawRoundRectRequest.ProtoReflect.Descriptor instead.
+func (*DrawRoundRectRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DrawRoundRectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawRoundRectRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DrawRoundRectRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *DrawRoundRectRequest) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *DrawRoundRectRequest) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+func (x *DrawRoundRectRequest) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+type DrawRoundRectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawRoundRectResponse) Reset() {
+	*x = DrawRoundRectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawRoundRectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawRoundRectResponse) ProtoMessage() {}
+
+func (x *DrawRoundRectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawRoundRectResponse.ProtoReflect.Descriptor instead.
+func (*DrawRoundRectResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{53}
+}
+
+type FillRoundRectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X    int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y    int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	W    int32  `protobuf:"varint,4,opt,name=w,proto3" json:"w,omitempty"`
+	H    int32  `protobuf:"varint,5,opt,name=h,proto3" json:"h,omitempty"`
+	R    int32  `protobuf:"varint,6,opt,name=r,proto3" json:"r,omitempty"`
+}
+
+func (x *FillRoundRectRequest) Reset() {
+	*x = FillRoundRectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillRoundRectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillRoundRectRequest) ProtoMessage() {}
+
+func (x *FillRoundRectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillRoundRectRequest.ProtoReflect.Descriptor instead.
+func (*FillRoundRectRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *FillRoundRectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FillRoundRectRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *FillRoundRectRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *FillRoundRectRequest) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *FillRoundRectRequest) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+func (x *FillRoundRectRequest) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+type FillRoundRectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FillRoundRectResponse) Reset() {
+	*x = FillRoundRectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillRoundRectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillRoundRectResponse) ProtoMessage() {}
+
+func (x *FillRoundRectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillRoundRectResponse.ProtoReflect.Descriptor instead.
+func (*FillRoundRectResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{55}
+}
+
+type DrawTriangleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X0   int32  `protobuf:"varint,2,opt,name=x0,proto3" json:"x0,omitempty"`
+	Y0   int32  `protobuf:"varint,3,opt,name=y0,proto3" json:"y0,omitempty"`
+	X1   int32  `protobuf:"varint,4,opt,name=x1,proto3" json:"x1,omitempty"`
+	Y1   int32  `protobuf:"varint,5,opt,name=y1,proto3" json:"y1,omitempty"`
+	X2   int32  `protobuf:"varint,6,opt,name=x2,proto3" json:"x2,omitempty"`
+	Y2   int32  `protobuf:"varint,7,opt,name=y2,proto3" json:"y2,omitempty"`
+}
+
+func (x *DrawTriangleRequest) Reset() {
+	*x = DrawTriangleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawTriangleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawTriangleRequest) ProtoMessage() {}
+
+func (x *DrawTriangleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawTriangleRequest.ProtoReflect.Descriptor instead.
+func (*DrawTriangleRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DrawTriangleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawTriangleRequest) GetX0() int32 {
+	if x != nil {
+		return x.X0
+	}
+	return 0
+}
+
+func (x *DrawTriangleRequest) GetY0() int32 {
+	if x != nil {
+		return x.Y0
+	}
+	return 0
+}
+
+func (x *DrawTriangleRequest) GetX1() int32 {
+	if x != nil {
+		return x.X1
+	}
+	return 0
+}
+
+func (x *DrawTriangleRequest) GetY1() int32 {
+	if x != nil {
+		return x.Y1
+	}
+	return 0
+}
+
+func (x *DrawTriangleRequest) GetX2() int32 {
+	if x != nil {
+		return x.X2
+	}
+	return 0
+}
+
+func (x *DrawTriangleRequest) GetY2() int32 {
+	if x != nil {
+		return x.Y2
+	}
+	return 0
+}
+
+type DrawTriangleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawTriangleResponse) Reset() {
+	*x = DrawTriangleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawTriangleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawTriangleResponse) ProtoMessage() {}
+
+func (x *DrawTriangleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawTriangleResponse.ProtoReflect.Descriptor instead.
+func (*DrawTriangleResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{57}
+}
+
+type FillTriangleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X0   int32  `protobuf:"varint,2,opt,name=x0,proto3" json:"x0,omitempty"`
+	Y0   int32  `protobuf:"varint,3,opt,name=y0,proto3" json:"y0,omitempty"`
+	X1   int32  `protobuf:"varint,4,opt,name=x1,proto3" json:"x1,omitempty"`
+	Y1   int32  `protobuf:"varint,5,opt,name=y1,proto3" json:"y1,omitempty"`
+	X2   int32  `protobuf:"varint,6,opt,name=x2,proto3" json:"x2,omitempty"`
+	Y2   int32  `protobuf:"varint,7,opt,name=y2,proto3" json:"y2,omitempty"`
+}
+
+func (x *FillTriangleRequest) Reset() {
+	*x = FillTriangleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillTriangleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillTriangleRequest) ProtoMessage() {}
+
+func (x *FillTriangleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillTriangleRequest.ProtoReflect.Descriptor instead.
+func (*FillTriangleRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FillTriangleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FillTriangleRequest) GetX0() int32 {
+	if x != nil {
+		return x.X0
+	}
+	return 0
+}
+
+func (x *FillTriangleRequest) GetY0() int32 {
+	if x != nil {
+		return x.Y0
+	}
+	return 0
+}
+
+func (x *FillTriangleRequest) GetX1() int32 {
+	if x != nil {
+		return x.X1
+	}
+	return 0
+}
+
+func (x *FillTriangleRequest) GetY1() int32 {
+	if x != nil {
+		return x.Y1
+	}
+	return 0
+}
+
+func (x *FillTriangleRequest) GetX2() int32 {
+	if x != nil {
+		return x.X2
+	}
+	return 0
+}
+
+func (x *FillTriangleRequest) GetY2() int32 {
+	if x != nil {
+		return x.Y2
+	}
+	return 0
+}
+
+type FillTriangleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FillTriangleResponse) Reset() {
+	*x = FillTriangleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillTriangleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillTriangleResponse) ProtoMessage() {}
+
+func (x *FillTriangleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillTriangleResponse.ProtoReflect.Descriptor instead.
+func (*FillTriangleResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{59}
+}
+
+type DrawBorderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X     int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y     int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	W     int32  `protobuf:"varint,4,opt,name=w,proto3" json:"w,omitempty"`
+	H     int32  `protobuf:"varint,5,opt,name=h,proto3" json:"h,omitempty"`
+	Style string `protobuf:"bytes,6,opt,name=style,proto3" json:"style,omitempty"`
+}
+
+func (x *DrawBorderRequest) Reset() {
+	*x = DrawBorderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawBorderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawBorderRequest) ProtoMessage() {}
+
+func (x *DrawBorderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawBorderRequest.ProtoReflect.Descriptor instead.
+func (*DrawBorderRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DrawBorderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawBorderRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DrawBorderRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *DrawBorderRequest) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *DrawBorderRequest) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+func (x *DrawBorderRequest) GetStyle() string {
+	if x != nil {
+		return x.Style
+	}
+	return ""
+}
+
+type DrawBorderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawBorderResponse) Reset() {
+	*x = DrawBorderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawBorderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawBorderResponse) ProtoMessage() {}
+
+func (x *DrawBorderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawBorderResponse.ProtoReflect.Descriptor instead.
+func (*DrawBorderResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{61}
+}
+
+type DrawGridRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X    int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y    int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	W    int32  `protobuf:"varint,4,opt,name=w,proto3" json:"w,omitempty"`
+	H    int32  `protobuf:"varint,5,opt,name=h,proto3" json:"h,omitempty"`
+	Cols int32  `protobuf:"varint,6,opt,name=cols,proto3" json:"cols,omitempty"`
+	Rows int32  `protobuf:"varint,7,opt,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *DrawGridRequest) Reset() {
+	*x = DrawGridRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawGridRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawGridRequest) ProtoMessage() {}
+
+func (x *DrawGridRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawGridRequest.ProtoReflect.Descriptor instead.
+func (*DrawGridRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DrawGridRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawGridRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DrawGridRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *DrawGridRequest) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *DrawGridRequest) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+func (x *DrawGridRequest) GetCols() int32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *DrawGridRequest) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+type DrawGridResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawGridResponse) Reset() {
+	*x = DrawGridResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawGridResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawGridResponse) ProtoMessage() {}
+
+func (x *DrawGridResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawGridResponse.ProtoReflect.Descriptor instead.
+func (*DrawGridResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{63}
+}
+
+type DrawWaterfallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X      int32     `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y      int32     `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	W      int32     `protobuf:"varint,4,opt,name=w,proto3" json:"w,omitempty"`
+	H      int32     `protobuf:"varint,5,opt,name=h,proto3" json:"h,omitempty"`
+	Column []float64 `protobuf:"fixed64,6,rep,packed,name=column,proto3" json:"column,omitempty"`
+}
+
+func (x *DrawWaterfallRequest) Reset() {
+	*x = DrawWaterfallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawWaterfallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawWaterfallRequest) ProtoMessage() {}
+
+func (x *DrawWaterfallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawWaterfallRequest.ProtoReflect.Descriptor instead.
+func (*DrawWaterfallRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DrawWaterfallRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawWaterfallRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DrawWaterfallRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *DrawWaterfallRequest) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *DrawWaterfallRequest) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+func (x *DrawWaterfallRequest) GetColumn() []float64 {
+	if x != nil {
+		return x.Column
+	}
+	return nil
+}
+
+type DrawWaterfallResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawWaterfallResponse) Reset() {
+	*x = DrawWaterfallResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawWaterfallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawWaterfallResponse) ProtoMessage() {}
+
+func (x *DrawWaterfallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawWaterfallResponse.ProtoReflect.Descriptor instead.
+func (*DrawWaterfallResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{65}
+}
+
+type DrawImageRegionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DstX int32  `protobuf:"varint,2,opt,name=dst_x,json=dstX,proto3" json:"dst_x,omitempty"`
+	DstY int32  `protobuf:"varint,3,opt,name=dst_y,json=dstY,proto3" json:"dst_y,omitempty"`
+	// The source image, encoded as PNG, JPEG or GIF.
+	Image []byte `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	SrcX  int32  `protobuf:"varint,5,opt,name=src_x,json=srcX,proto3" json:"src_x,omitempty"`
+	SrcY  int32  `protobuf:"varint,6,opt,name=src_y,json=srcY,proto3" json:"src_y,omitempty"`
+	W     int32  `protobuf:"varint,7,opt,name=w,proto3" json:"w,omitempty"`
+	H     int32  `protobuf:"varint,8,opt,name=h,proto3" json:"h,omitempty"`
+}
+
+func (x *DrawImageRegionRequest) Reset() {
+	*x = DrawImageRegionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawImageRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawImageRegionRequest) ProtoMessage() {}
+
+func (x *DrawImageRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawImageRegionRequest.ProtoReflect.Descriptor instead.
+func (*DrawImageRegionRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DrawImageRegionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawImageRegionRequest) GetDstX() int32 {
+	if x != nil {
+		return x.DstX
+	}
+	return 0
+}
+
+func (x *DrawImageRegionRequest) GetDstY() int32 {
+	if x != nil {
+		return x.DstY
+	}
+	return 0
+}
+
+func (x *DrawImageRegionRequest) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *DrawImageRegionRequest) GetSrcX() int32 {
+	if x != nil {
+		return x.SrcX
+	}
+	return 0
+}
+
+func (x *DrawImageRegionRequest) GetSrcY() int32 {
+	if x != nil {
+		return x.SrcY
+	}
+	return 0
+}
+
+func (x *DrawImageRegionRequest) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *DrawImageRegionRequest) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+type DrawImageRegionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawImageRegionResponse) Reset() {
+	*x = DrawImageRegionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawImageRegionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawImageRegionResponse) ProtoMessage() {}
+
+func (x *DrawImageRegionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawImageRegionResponse.ProtoReflect.Descriptor instead.
+func (*DrawImageRegionResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{67}
+}
+
+type DrawBitmapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image []byte `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	X     int32  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y     int32  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *DrawBitmapRequest) Reset() {
+	*x = DrawBitmapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawBitmapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawBitmapRequest) ProtoMessage() {}
+
+func (x *DrawBitmapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawBitmapRequest.ProtoReflect.Descriptor instead.
+func (*DrawBitmapRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DrawBitmapRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawBitmapRequest) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *DrawBitmapRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DrawBitmapRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type DrawBitmapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawBitmapResponse) Reset() {
+	*x = DrawBitmapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawBitmapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawBitmapResponse) ProtoMessage() {}
+
+func (x *DrawBitmapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawBitmapResponse.ProtoReflect.Descriptor instead.
+func (*DrawBitmapResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{69}
+}
+
+type DrawBitmapDitheredRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image []byte `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	X     int32  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y     int32  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *DrawBitmapDitheredRequest) Reset() {
+	*x = DrawBitmapDitheredRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawBitmapDitheredRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawBitmapDitheredRequest) ProtoMessage() {}
+
+func (x *DrawBitmapDitheredRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawBitmapDitheredRequest.ProtoReflect.Descriptor instead.
+func (*DrawBitmapDitheredRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DrawBitmapDitheredRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawBitmapDitheredRequest) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *DrawBitmapDitheredRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DrawBitmapDitheredRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type DrawBitmapDitheredResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawBitmapDitheredResponse) Reset() {
+	*x = DrawBitmapDitheredResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawBitmapDitheredResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawBitmapDitheredResponse) ProtoMessage() {}
+
+func (x *DrawBitmapDitheredResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawBitmapDitheredResponse.ProtoReflect.Descriptor instead.
+func (*DrawBitmapDitheredResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{71}
+}
+
+type DrawBitmapRLERequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X       int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y       int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	W       int32  `protobuf:"varint,4,opt,name=w,proto3" json:"w,omitempty"`
+	H       int32  `protobuf:"varint,5,opt,name=h,proto3" json:"h,omitempty"`
+	Encoded []byte `protobuf:"bytes,6,opt,name=encoded,proto3" json:"encoded,omitempty"`
+}
+
+func (x *DrawBitmapRLERequest) Reset() {
+	*x = DrawBitmapRLERequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawBitmapRLERequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawBitmapRLERequest) ProtoMessage() {}
+
+func (x *DrawBitmapRLERequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawBitmapRLERequest.ProtoReflect.Descriptor instead.
+func (*DrawBitmapRLERequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DrawBitmapRLERequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawBitmapRLERequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DrawBitmapRLERequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *DrawBitmapRLERequest) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *DrawBitmapRLERequest) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+func (x *DrawBitmapRLERequest) GetEncoded() []byte {
+	if x != nil {
+		return x.Encoded
+	}
+	return nil
+}
+
+type DrawBitmapRLEResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawBitmapRLEResponse) Reset() {
+	*x = DrawBitmapRLEResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawBitmapRLEResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawBitmapRLEResponse) ProtoMessage() {}
+
+func (x *DrawBitmapRLEResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawBitmapRLEResponse.ProtoReflect.Descriptor instead.
+func (*DrawBitmapRLEResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{73}
+}
+
+type DrawProgressBarLabeledRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X       int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y       int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	W       int32  `protobuf:"varint,4,opt,name=w,proto3" json:"w,omitempty"`
+	H       int32  `protobuf:"varint,5,opt,name=h,proto3" json:"h,omitempty"`
+	Percent int32  `protobuf:"varint,6,opt,name=percent,proto3" json:"percent,omitempty"`
+	Label   string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *DrawProgressBarLabeledRequest) Reset() {
+	*x = DrawProgressBarLabeledRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawProgressBarLabeledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawProgressBarLabeledRequest) ProtoMessage() {}
+
+func (x *DrawProgressBarLabeledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawProgressBarLabeledRequest.ProtoReflect.Descriptor instead.
+func (*DrawProgressBarLabeledRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DrawProgressBarLabeledRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawProgressBarLabeledRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DrawProgressBarLabeledRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *DrawProgressBarLabeledRequest) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *DrawProgressBarLabeledRequest) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+func (x *DrawProgressBarLabeledRequest) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *DrawProgressBarLabeledRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type DrawProgressBarLabeledResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawProgressBarLabeledResponse) Reset() {
+	*x = DrawProgressBarLabeledResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawProgressBarLabeledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawProgressBarLabeledResponse) ProtoMessage() {}
+
+func (x *DrawProgressBarLabeledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawProgressBarLabeledResponse.ProtoReflect.Descriptor instead.
+func (*DrawProgressBarLabeledResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{75}
+}
+
+type DrawAxesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X      int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y      int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	W      int32  `protobuf:"varint,4,opt,name=w,proto3" json:"w,omitempty"`
+	H      int32  `protobuf:"varint,5,opt,name=h,proto3" json:"h,omitempty"`
+	XLabel string `protobuf:"bytes,6,opt,name=x_label,json=xLabel,proto3" json:"x_label,omitempty"`
+	YLabel string `protobuf:"bytes,7,opt,name=y_label,json=yLabel,proto3" json:"y_label,omitempty"`
+	Ticks  int32  `protobuf:"varint,8,opt,name=ticks,proto3" json:"ticks,omitempty"`
+}
+
+func (x *DrawAxesRequest) Reset() {
+	*x = DrawAxesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawAxesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawAxesRequest) ProtoMessage() {}
+
+func (x *DrawAxesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawAxesRequest.ProtoReflect.Descriptor instead.
+func (*DrawAxesRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DrawAxesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawAxesRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DrawAxesRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *DrawAxesRequest) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *DrawAxesRequest) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+func (x *DrawAxesRequest) GetXLabel() string {
+	if x != nil {
+		return x.XLabel
+	}
+	return ""
+}
+
+func (x *DrawAxesRequest) GetYLabel() string {
+	if x != nil {
+		return x.YLabel
+	}
+	return ""
+}
+
+func (x *DrawAxesRequest) GetTicks() int32 {
+	if x != nil {
+		return x.Ticks
+	}
+	return 0
+}
+
+type DrawAxesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawAxesResponse) Reset() {
+	*x = DrawAxesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawAxesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawAxesResponse) ProtoMessage() {}
+
+func (x *DrawAxesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawAxesResponse.ProtoReflect.Descriptor instead.
+func (*DrawAxesResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{77}
+}
+
+type DrawDPadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cx      int32  `protobuf:"varint,2,opt,name=cx,proto3" json:"cx,omitempty"`
+	Cy      int32  `protobuf:"varint,3,opt,name=cy,proto3" json:"cy,omitempty"`
+	Size    int32  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Pressed string `protobuf:"bytes,5,opt,name=pressed,proto3" json:"pressed,omitempty"`
+}
+
+func (x *DrawDPadRequest) Reset() {
+	*x = DrawDPadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawDPadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawDPadRequest) ProtoMessage() {}
+
+func (x *DrawDPadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawDPadRequest.ProtoReflect.Descriptor instead.
+func (*DrawDPadRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DrawDPadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawDPadRequest) GetCx() int32 {
+	if x != nil {
+		return x.Cx
+	}
+	return 0
+}
+
+func (x *DrawDPadRequest) GetCy() int32 {
+	if x != nil {
+		return x.Cy
+	}
+	return 0
+}
+
+func (x *DrawDPadRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DrawDPadRequest) GetPressed() string {
+	if x != nil {
+		return x.Pressed
+	}
+	return ""
+}
+
+type DrawDPadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawDPadResponse) Reset() {
+	*x = DrawDPadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawDPadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawDPadResponse) ProtoMessage() {}
+
+func (x *DrawDPadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawDPadResponse.ProtoReflect.Descriptor instead.
+func (*DrawDPadResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{79}
+}
+
+type DrawKeyValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X      int32    `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y      int32    `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	Keys   []string `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys,omitempty"`
+	Values []string `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty"`
+	ColGap int32    `protobuf:"varint,6,opt,name=col_gap,json=colGap,proto3" json:"col_gap,omitempty"`
+}
+
+func (x *DrawKeyValuesRequest) Reset() {
+	*x = DrawKeyValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawKeyValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawKeyValuesRequest) ProtoMessage() {}
+
+func (x *DrawKeyValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawKeyValuesRequest.ProtoReflect.Descriptor instead.
+func (*DrawKeyValuesRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DrawKeyValuesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawKeyValuesRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DrawKeyValuesRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *DrawKeyValuesRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *DrawKeyValuesRequest) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *DrawKeyValuesRequest) GetColGap() int32 {
+	if x != nil {
+		return x.ColGap
+	}
+	return 0
+}
+
+type DrawKeyValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawKeyValuesResponse) Reset() {
+	*x = DrawKeyValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawKeyValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawKeyValuesResponse) ProtoMessage() {}
+
+func (x *DrawKeyValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawKeyValuesResponse.ProtoReflect.Descriptor instead.
+func (*DrawKeyValuesResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{81}
+}
+
+// One drawing primitive of a batch. Which fields are used depends on op, see displayapi.DrawOp.
+type DrawOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op   string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	X    int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y    int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	X2   int32  `protobuf:"varint,4,opt,name=x2,proto3" json:"x2,omitempty"`
+	Y2   int32  `protobuf:"varint,5,opt,name=y2,proto3" json:"y2,omitempty"`
+	W    int32  `protobuf:"varint,6,opt,name=w,proto3" json:"w,omitempty"`
+	H    int32  `protobuf:"varint,7,opt,name=h,proto3" json:"h,omitempty"`
+	Text string `protobuf:"bytes,8,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *DrawOp) Reset() {
+	*x = DrawOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawOp) ProtoMessage() {}
+
+func (x *DrawOp) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawOp.ProtoReflect.Descriptor instead.
+func (*DrawOp) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DrawOp) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *DrawOp) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DrawOp) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *DrawOp) GetX2() int32 {
+	if x != nil {
+		return x.X2
+	}
+	return 0
+}
+
+func (x *DrawOp) GetY2() int32 {
+	if x != nil {
+		return x.Y2
+	}
+	return 0
+}
+
+func (x *DrawOp) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *DrawOp) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+func (x *DrawOp) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type BatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ops  []*DrawOp `protobuf:"bytes,2,rep,name=ops,proto3" json:"ops,omitempty"`
+}
+
+func (x *BatchRequest) Reset() {
+	*x = BatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRequest) ProtoMessage() {}
+
+func (x *BatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRequest.ProtoReflect.Descriptor instead.
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *BatchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BatchRequest) GetOps() []*DrawOp {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+type BatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BatchResponse) Reset() {
+	*x = BatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponse) ProtoMessage() {}
+
+func (x *BatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{84}
+}
+
+type DrawTestPatternRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pattern string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+}
+
+func (x *DrawTestPatternRequest) Reset() {
+	*x = DrawTestPatternRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawTestPatternRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawTestPatternRequest) ProtoMessage() {}
+
+func (x *DrawTestPatternRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawTestPatternRequest.ProtoReflect.Descriptor instead.
+func (*DrawTestPatternRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DrawTestPatternRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrawTestPatternRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type DrawTestPatternResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawTestPatternResponse) Reset() {
+	*x = DrawTestPatternResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawTestPatternResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawTestPatternResponse) ProtoMessage() {}
+
+func (x *DrawTestPatternResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawTestPatternResponse.ProtoReflect.Descriptor instead.
+func (*DrawTestPatternResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{86}
+}
+
+type DrawCalibrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DrawCalibrationRequest) Reset() {
+	*x = DrawCalibrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawCalibrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawCalibrationRequest) ProtoMessage() {}
+
+func (x *DrawCalibrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawCalibrationRequest.ProtoReflect.Descriptor instead.
+func (*DrawCalibrationRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DrawCalibrationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DrawCalibrationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrawCalibrationResponse) Reset() {
+	*x = DrawCalibrationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawCalibrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawCalibrationResponse) ProtoMessage() {}
+
+func (x *DrawCalibrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawCalibrationResponse.ProtoReflect.Descriptor instead.
+func (*DrawCalibrationResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{88}
+}
+
+type DisplayScaledRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data   []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	SrcW   int32  `protobuf:"varint,3,opt,name=src_w,json=srcW,proto3" json:"src_w,omitempty"`
+	SrcH   int32  `protobuf:"varint,4,opt,name=src_h,json=srcH,proto3" json:"src_h,omitempty"`
+	Factor int32  `protobuf:"varint,5,opt,name=factor,proto3" json:"factor,omitempty"`
+}
+
+func (x *DisplayScaledRequest) Reset() {
+	*x = DisplayScaledRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisplayScaledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisplayScaledRequest) ProtoMessage() {}
+
+func (x *DisplayScaledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisplayScaledRequest.ProtoReflect.Descriptor instead.
+func (*DisplayScaledRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DisplayScaledRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DisplayScaledRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DisplayScaledRequest) GetSrcW() int32 {
+	if x != nil {
+		return x.SrcW
+	}
+	return 0
+}
+
+func (x *DisplayScaledRequest) GetSrcH() int32 {
+	if x != nil {
+		return x.SrcH
+	}
+	return 0
+}
+
+func (x *DisplayScaledRequest) GetFactor() int32 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+type DisplayScaledResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisplayScaledResponse) Reset() {
+	*x = DisplayScaledResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisplayScaledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisplayScaledResponse) ProtoMessage() {}
+
+func (x *DisplayScaledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisplayScaledResponse.ProtoReflect.Descriptor instead.
+func (*DisplayScaledResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{90}
+}
+
+type GetDimensionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetDimensionsRequest) Reset() {
+	*x = GetDimensionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDimensionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDimensionsRequest) ProtoMessage() {}
+
+func (x *GetDimensionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDimensionsRequest.ProtoReflect.Descriptor instead.
+func (*GetDimensionsRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetDimensionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetDimensionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Width  int32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *GetDimensionsResponse) Reset() {
+	*x = GetDimensionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDimensionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDimensionsResponse) ProtoMessage() {}
+
+func (x *GetDimensionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDimensionsResponse.ProtoReflect.Descriptor instead.
+func (*GetDimensionsResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetDimensionsResponse) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *GetDimensionsResponse) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type GetPixelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X    int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y    int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *GetPixelRequest) Reset() {
+	*x = GetPixelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPixelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPixelRequest) ProtoMessage() {}
+
+func (x *GetPixelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPixelRequest.ProtoReflect.Descriptor instead.
+func (*GetPixelRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetPixelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPixelRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *GetPixelRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type GetPixelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	On bool `protobuf:"varint,1,opt,name=on,proto3" json:"on,omitempty"`
+}
+
+func (x *GetPixelResponse) Reset() {
+	*x = GetPixelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPixelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPixelResponse) ProtoMessage() {}
+
+func (x *GetPixelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPixelResponse.ProtoReflect.Descriptor instead.
+func (*GetPixelResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetPixelResponse) GetOn() bool {
+	if x != nil {
+		return x.On
+	}
+	return false
+}
+
+type GetRegionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X    int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y    int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	W    int32  `protobuf:"varint,4,opt,name=w,proto3" json:"w,omitempty"`
+	H    int32  `protobuf:"varint,5,opt,name=h,proto3" json:"h,omitempty"`
+}
+
+func (x *GetRegionRequest) Reset() {
+	*x = GetRegionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegionRequest) ProtoMessage() {}
+
+func (x *GetRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegionRequest.ProtoReflect.Descriptor instead.
+func (*GetRegionRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetRegionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetRegionRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *GetRegionRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *GetRegionRequest) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *GetRegionRequest) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+type GetRegionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetRegionResponse) Reset() {
+	*x = GetRegionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRegionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegionResponse) ProtoMessage() {}
+
+func (x *GetRegionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegionResponse.ProtoReflect.Descriptor instead.
+func (*GetRegionResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetRegionResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ReadBufferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ReadBufferRequest) Reset() {
+	*x = ReadBufferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadBufferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadBufferRequest) ProtoMessage() {}
+
+func (x *ReadBufferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadBufferRequest.ProtoReflect.Descriptor instead.
+func (*ReadBufferRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ReadBufferRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ReadBufferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ReadBufferResponse) Reset() {
+	*x = ReadBufferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadBufferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadBufferResponse) ProtoMessage() {}
+
+func (x *ReadBufferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadBufferResponse.ProtoReflect.Descriptor instead.
+func (*ReadBufferResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ReadBufferResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SetRegionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X    int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y    int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	W    int32  `protobuf:"varint,4,opt,name=w,proto3" json:"w,omitempty"`
+	H    int32  `protobuf:"varint,5,opt,name=h,proto3" json:"h,omitempty"`
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SetRegionRequest) Reset() {
+	*x = SetRegionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRegionRequest) ProtoMessage() {}
+
+func (x *SetRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRegionRequest.ProtoReflect.Descriptor instead.
+func (*SetRegionRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SetRegionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetRegionRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *SetRegionRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *SetRegionRequest) GetW() int32 {
+	if x != nil {
+		return x.W
+	}
+	return 0
+}
+
+func (x *SetRegionRequest) GetH() int32 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+func (x *SetRegionRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SetRegionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetRegionResponse) Reset() {
+	*x = SetRegionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRegionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRegionResponse) ProtoMessage() {}
+
+func (x *SetRegionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRegionResponse.ProtoReflect.Descriptor instead.
+func (*SetRegionResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{100}
+}
+
+type SetScaleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Factor float64 `protobuf:"fixed64,2,opt,name=factor,proto3" json:"factor,omitempty"`
+}
+
+func (x *SetScaleRequest) Reset() {
+	*x = SetScaleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetScaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScaleRequest) ProtoMessage() {}
+
+func (x *SetScaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScaleRequest.ProtoReflect.Descriptor instead.
+func (*SetScaleRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SetScaleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetScaleRequest) GetFactor() float64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+type SetScaleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetScaleResponse) Reset() {
+	*x = SetScaleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetScaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScaleResponse) ProtoMessage() {}
+
+func (x *SetScaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScaleResponse.ProtoReflect.Descriptor instead.
+func (*SetScaleResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{102}
+}
+
+type SetFontRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Font string `protobuf:"bytes,2,opt,name=font,proto3" json:"font,omitempty"`
+}
+
+func (x *SetFontRequest) Reset() {
+	*x = SetFontRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFontRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFontRequest) ProtoMessage() {}
+
+func (x *SetFontRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFontRequest.ProtoReflect.Descriptor instead.
+func (*SetFontRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SetFontRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetFontRequest) GetFont() string {
+	if x != nil {
+		return x.Font
+	}
+	return ""
+}
+
+type SetFontResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetFontResponse) Reset() {
+	*x = SetFontResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFontResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFontResponse) ProtoMessage() {}
+
+func (x *SetFontResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFontResponse.ProtoReflect.Descriptor instead.
+func (*SetFontResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{104}
+}
+
+type SetContrastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Level int32  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *SetContrastRequest) Reset() {
+	*x = SetContrastRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetContrastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContrastRequest) ProtoMessage() {}
+
+func (x *SetContrastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContrastRequest.ProtoReflect.Descriptor instead.
+func (*SetContrastRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *SetContrastRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetContrastRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type SetContrastResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetContrastResponse) Reset() {
+	*x = SetContrastResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetContrastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContrastResponse) ProtoMessage() {}
+
+func (x *SetContrastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContrastResponse.ProtoReflect.Descriptor instead.
+func (*SetContrastResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{106}
+}
+
+type SetInvertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Invert bool   `protobuf:"varint,2,opt,name=invert,proto3" json:"invert,omitempty"`
+}
+
+func (x *SetInvertRequest) Reset() {
+	*x = SetInvertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInvertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInvertRequest) ProtoMessage() {}
+
+func (x *SetInvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInvertRequest.ProtoReflect.Descriptor instead.
+func (*SetInvertRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *SetInvertRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetInvertRequest) GetInvert() bool {
+	if x != nil {
+		return x.Invert
+	}
+	return false
+}
+
+type SetInvertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetInvertResponse) Reset() {
+	*x = SetInvertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInvertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInvertResponse) ProtoMessage() {}
+
+func (x *SetInvertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInvertResponse.ProtoReflect.Descriptor instead.
+func (*SetInvertResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{108}
+}
+
+type SetDisplayOnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	On   bool   `protobuf:"varint,2,opt,name=on,proto3" json:"on,omitempty"`
+}
+
+func (x *SetDisplayOnRequest) Reset() {
+	*x = SetDisplayOnRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDisplayOnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDisplayOnRequest) ProtoMessage() {}
+
+func (x *SetDisplayOnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDisplayOnRequest.ProtoReflect.Descriptor instead.
+func (*SetDisplayOnRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *SetDisplayOnRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetDisplayOnRequest) GetOn() bool {
+	if x != nil {
+		return x.On
+	}
+	return false
+}
+
+type SetDisplayOnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetDisplayOnResponse) Reset() {
+	*x = SetDisplayOnResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDisplayOnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDisplayOnResponse) ProtoMessage() {}
+
+func (x *SetDisplayOnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDisplayOnResponse.ProtoReflect.Descriptor instead.
+func (*SetDisplayOnResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{110}
+}
+
+type ReadStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ReadStatusRequest) Reset() {
+	*x = ReadStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadStatusRequest) ProtoMessage() {}
+
+func (x *ReadStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadStatusRequest.ProtoReflect.Descriptor instead.
+func (*ReadStatusRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ReadStatusRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ReadStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status uint32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ReadStatusResponse) Reset() {
+	*x = ReadStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadStatusResponse) ProtoMessage() {}
+
+func (x *ReadStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadStatusResponse.ProtoReflect.Descriptor instead.
+func (*ReadStatusResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ReadStatusResponse) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type DetectControllerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DetectControllerRequest) Reset() {
+	*x = DetectControllerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectControllerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectControllerRequest) ProtoMessage() {}
+
+func (x *DetectControllerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectControllerRequest.ProtoReflect.Descriptor instead.
+func (*DetectControllerRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *DetectControllerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DetectControllerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+}
+
+func (x *DetectControllerResponse) Reset() {
+	*x = DetectControllerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectControllerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectControllerResponse) ProtoMessage() {}
+
+func (x *DetectControllerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectControllerResponse.ProtoReflect.Descriptor instead.
+func (*DetectControllerResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *DetectControllerResponse) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+type UndoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *UndoRequest) Reset() {
+	*x = UndoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoRequest) ProtoMessage() {}
+
+func (x *UndoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoRequest.ProtoReflect.Descriptor instead.
+func (*UndoRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *UndoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UndoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UndoResponse) Reset() {
+	*x = UndoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoResponse) ProtoMessage() {}
+
+func (x *UndoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoResponse.ProtoReflect.Descriptor instead.
+func (*UndoResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{116}
+}
+
+type RedoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RedoRequest) Reset() {
+	*x = RedoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedoRequest) ProtoMessage() {}
+
+func (x *RedoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedoRequest.ProtoReflect.Descriptor instead.
+func (*RedoRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *RedoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RedoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RedoResponse) Reset() {
+	*x = RedoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedoResponse) ProtoMessage() {}
+
+func (x *RedoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedoResponse.ProtoReflect.Descriptor instead.
+func (*RedoResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{118}
+}
+
+type RefreshRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RefreshRequest) Reset() {
+	*x = RefreshRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshRequest) ProtoMessage() {}
+
+func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *RefreshRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RefreshResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RefreshResponse) Reset() {
+	*x = RefreshResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshResponse) ProtoMessage() {}
+
+func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshResponse.ProtoReflect.Descriptor instead.
+func (*RefreshResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{120}
+}
+
+type ClearRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ClearRequest) Reset() {
+	*x = ClearRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRequest) ProtoMessage() {}
+
+func (x *ClearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRequest.ProtoReflect.Descriptor instead.
+func (*ClearRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ClearRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ClearResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearResponse) Reset() {
+	*x = ClearResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearResponse) ProtoMessage() {}
+
+func (x *ClearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearResponse.ProtoReflect.Descriptor instead.
+func (*ClearResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{122}
+}
+
+type ResetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ResetRequest) Reset() {
+	*x = ResetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetRequest) ProtoMessage() {}
+
+func (x *ResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetRequest.ProtoReflect.Descriptor instead.
+func (*ResetRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ResetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ResetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResetResponse) Reset() {
+	*x = ResetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetResponse) ProtoMessage() {}
+
+func (x *ResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetResponse.ProtoReflect.Descriptor instead.
+func (*ResetResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{124}
+}
+
+type DoCommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Command *structpb.Struct `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *DoCommandRequest) Reset() {
+	*x = DoCommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoCommandRequest) ProtoMessage() {}
+
+func (x *DoCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoCommandRequest.ProtoReflect.Descriptor instead.
+func (*DoCommandRequest) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *DoCommandRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DoCommandRequest) GetCommand() *structpb.Struct {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+type DoCommandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *structpb.Struct `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *DoCommandResponse) Reset() {
+	*x = DoCommandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_display_v1_display_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoCommandResponse) ProtoMessage() {}
+
+func (x *DoCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_display_v1_display_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoCommandResponse.ProtoReflect.Descriptor instead.
+func (*DoCommandResponse) Descriptor() ([]byte, []int) {
+	return file_component_display_v1_display_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *DoCommandResponse) GetResult() *structpb.Struct {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+var File_component_display_v1_display_proto protoreflect.FileDescriptor
+
+var file_component_display_v1_display_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x8a, 0x01, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x05, 0x63, 0x72, 0x63, 0x33, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x05,
+	0x63, 0x72, 0x63, 0x33, 0x32, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x72, 0x63, 0x33, 0x32, 0x22, 0x16, 0x0a,
+	0x14, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x16, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x4c, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x22, 0x19, 0x0a,
+	0x17, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x4c, 0x45,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x18, 0x44, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x44, 0x69, 0x72, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x69, 0x72, 0x74, 0x79, 0x5f, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x64, 0x69, 0x72, 0x74, 0x79, 0x58, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x69, 0x72, 0x74, 0x79, 0x5f,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x69, 0x72, 0x74, 0x79, 0x59, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x69, 0x72, 0x74, 0x79, 0x5f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x64, 0x69, 0x72, 0x74, 0x79, 0x57, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x69, 0x72, 0x74,
+	0x79, 0x5f, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x69, 0x72, 0x74, 0x79,
+	0x48, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x44, 0x69, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64,
+	0x0a, 0x12, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x78, 0x6c, 0x6f, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x78, 0x6c, 0x6f, 0x63, 0x12, 0x12, 0x0a, 0x04,
+	0x79, 0x6c, 0x6f, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x6c, 0x6f, 0x63,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x18, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x18, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x78, 0x6c, 0x6f,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x78, 0x6c, 0x6f, 0x63, 0x12, 0x12, 0x0a,
+	0x04, 0x79, 0x6c, 0x6f, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x6c, 0x6f,
+	0x63, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x1b, 0x0a,
+	0x19, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x61, 0x6c,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x18, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x45, 0x0a, 0x19, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x65, 0x6e, 0x64, 0x5f, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x65, 0x6e, 0x64, 0x58, 0x12, 0x13, 0x0a, 0x05,
+	0x65, 0x6e, 0x64, 0x5f, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x65, 0x6e, 0x64,
+	0x59, 0x22, 0x88, 0x01, 0x0a, 0x19, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x78, 0x6c, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x78, 0x6c, 0x6f, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x6c, 0x6f, 0x63, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x6c, 0x6f, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x57, 0x69, 0x64, 0x74, 0x68, 0x22, 0x32, 0x0a, 0x1a,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x22, 0x7c, 0x0a, 0x19, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12,
+	0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x1b, 0x0a,
+	0x09, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x65, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x1c,
+	0x0a, 0x1a, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7e, 0x0a, 0x1a,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x74, 0x69,
+	0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c,
+	0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1d, 0x0a, 0x1b,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x74, 0x69,
+	0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x1a,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x6f, 0x6e, 0x67,
+	0x41, 0x72, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x63, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x63, 0x78, 0x12, 0x0e,
+	0x0a, 0x02, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x63, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x64, 0x65, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x65, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x6f, 0x6e, 0x67, 0x41, 0x72, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc6, 0x01, 0x0a, 0x1a, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x6e, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x61, 0x72,
+	0x5f, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x61, 0x72, 0x58, 0x12, 0x13,
+	0x0a, 0x05, 0x62, 0x61, 0x72, 0x5f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62,
+	0x61, 0x72, 0x59, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x61, 0x72, 0x5f, 0x77, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x62, 0x61, 0x72, 0x57, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x61, 0x72, 0x5f,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x61, 0x72, 0x48, 0x12, 0x15, 0x0a,
+	0x06, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74,
+	0x65, 0x78, 0x74, 0x58, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x65, 0x78, 0x74, 0x59, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0x1d, 0x0a, 0x1b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x6e,
+	0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f,
+	0x0a, 0x11, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22,
+	0x14, 0x0a, 0x12, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53,
+	0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x67, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x27, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x70,
+	0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e,
+	0x0a, 0x14, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x45,
+	0x0a, 0x15, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x5f, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x10, 0x44, 0x72, 0x61,
+	0x77, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12,
+	0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x13, 0x0a,
+	0x11, 0x44, 0x72, 0x61, 0x77, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x43, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x50, 0x69, 0x78, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x50, 0x69, 0x78, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a,
+	0x0f, 0x44, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x78, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x79, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x79, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x78, 0x32, 0x12, 0x0e, 0x0a, 0x02, 0x79, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x79, 0x32, 0x22, 0x12, 0x0a, 0x10, 0x44, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x0f, 0x44, 0x72, 0x61, 0x77,
+	0x52, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a,
+	0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x77,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x77, 0x12, 0x0c, 0x0a, 0x01, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x68, 0x22, 0x12, 0x0a, 0x10, 0x44, 0x72, 0x61, 0x77, 0x52,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x0f, 0x46,
+	0x69, 0x6c, 0x6c, 0x52, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78,
+	0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x0c,
+	0x0a, 0x01, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x77, 0x12, 0x0c, 0x0a, 0x01,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x68, 0x22, 0x12, 0x0a, 0x10, 0x46, 0x69,
+	0x6c, 0x6c, 0x52, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55,
+	0x0a, 0x11, 0x44, 0x72, 0x61, 0x77, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x63, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x63, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x01, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x72, 0x61, 0x77, 0x43, 0x69, 0x72,
+	0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x11, 0x46,
+	0x69, 0x6c, 0x6c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x63, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x63, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x01, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x46, 0x69, 0x6c, 0x6c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x12, 0x44, 0x72, 0x61, 0x77,
+	0x45, 0x6c, 0x6c, 0x69, 0x70, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x63, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x63, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x72, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x72, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x72, 0x79, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x72, 0x61, 0x77, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x12, 0x46, 0x69, 0x6c,
+	0x6c, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x63, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x63, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x72, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x72, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x72, 0x79, 0x22, 0x15, 0x0a, 0x13, 0x46, 0x69, 0x6c, 0x6c, 0x45, 0x6c, 0x6c, 0x69, 0x70,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x70, 0x0a, 0x14, 0x44, 0x72,
+	0x61, 0x77, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x77,
+	0x12, 0x0c, 0x0a, 0x01, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x68, 0x12, 0x0c,
+	0x0a, 0x01, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x72, 0x22, 0x17, 0x0a, 0x15,
+	0x44, 0x72, 0x61, 0x77, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x70, 0x0a, 0x14, 0x46, 0x69, 0x6c, 0x6c, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12,
+	0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a,
+	0x01, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x77, 0x12, 0x0c, 0x0a, 0x01, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x68, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x72, 0x22, 0x17, 0x0a, 0x15, 0x46, 0x69, 0x6c, 0x6c, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x89, 0x01, 0x0a, 0x13, 0x44, 0x72, 0x61, 0x77, 0x54, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x78, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x78, 0x30, 0x12, 0x0e, 0x0a, 0x02,
+	0x79, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x79, 0x30, 0x12, 0x0e, 0x0a, 0x02,
+	0x78, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x78, 0x31, 0x12, 0x0e, 0x0a, 0x02,
+	0x79, 0x31, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x79, 0x31, 0x12, 0x0e, 0x0a, 0x02,
+	0x78, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x78, 0x32, 0x12, 0x0e, 0x0a, 0x02,
+	0x79, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x79, 0x32, 0x22, 0x16, 0x0a, 0x14,
+	0x44, 0x72, 0x61, 0x77, 0x54, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6c, 0x6c, 0x54, 0x72, 0x69,
+	0x61, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x78, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x78, 0x30,
+	0x12, 0x0e, 0x0a, 0x02, 0x79, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x79, 0x30,
+	0x12, 0x0e, 0x0a, 0x02, 0x78, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x78, 0x31,
+	0x12, 0x0e, 0x0a, 0x02, 0x79, 0x31, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x79, 0x31,
+	0x12, 0x0e, 0x0a, 0x02, 0x78, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x78, 0x32,
+	0x12, 0x0e, 0x0a, 0x02, 0x79, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x79, 0x32,
+	0x22, 0x16, 0x0a, 0x14, 0x46, 0x69, 0x6c, 0x6c, 0x54, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x11, 0x44, 0x72, 0x61, 0x77,
+	0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12,
+	0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a,
+	0x01, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x77, 0x12, 0x0c, 0x0a, 0x01, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x79,
+	0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x22,
+	0x14, 0x0a, 0x12, 0x44, 0x72, 0x61, 0x77, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x44, 0x72, 0x61, 0x77, 0x47, 0x72,
+	0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a,
+	0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x77, 0x12, 0x0c, 0x0a, 0x01, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x01, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x12, 0x0a,
+	0x10, 0x44, 0x72, 0x61, 0x77, 0x47, 0x72, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x7a, 0x0a, 0x14, 0x44, 0x72, 0x61, 0x77, 0x57, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a,
+	0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x77, 0x12, 0x0c, 0x0a, 0x01, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x01, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x17, 0x0a,
+	0x15, 0x44, 0x72, 0x61, 0x77, 0x57, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x16, 0x44, 0x72, 0x61, 0x77, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x64, 0x73, 0x74, 0x5f, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x73, 0x74, 0x58, 0x12, 0x13, 0x0a, 0x05, 0x64, 0x73,
+	0x74, 0x5f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x73, 0x74, 0x59, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x72, 0x63, 0x5f, 0x78, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x72, 0x63, 0x58, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x72,
+	0x63, 0x5f, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x72, 0x63, 0x59, 0x12,
+	0x0c, 0x0a, 0x01, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x77, 0x12, 0x0c, 0x0a,
+	0x01, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x68, 0x22, 0x19, 0x0a, 0x17, 0x44,
+	0x72, 0x61, 0x77, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x11, 0x44, 0x72, 0x61, 0x77, 0x42, 0x69,
+	0x74, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01,
+	0x79, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x72, 0x61, 0x77, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0x0a, 0x19, 0x44, 0x72, 0x61, 0x77, 0x42,
+	0x69, 0x74, 0x6d, 0x61, 0x70, 0x44, 0x69, 0x74, 0x68, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0c,
+	0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x72,
+	0x61, 0x77, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x44, 0x69, 0x74, 0x68, 0x65, 0x72, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x0a, 0x14, 0x44, 0x72, 0x61, 0x77,
+	0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x4c, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79,
+	0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x77, 0x12, 0x0c,
+	0x0a, 0x01, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x68, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x72, 0x61, 0x77, 0x42, 0x69,
+	0x74, 0x6d, 0x61, 0x70, 0x52, 0x4c, 0x45, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x9b, 0x01, 0x0a, 0x1d, 0x44, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x42, 0x61, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01,
+	0x79, 0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x77, 0x12,
+	0x0c, 0x0a, 0x01, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x20, 0x0a,
+	0x1e, 0x44, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x42, 0x61, 0x72,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xa5, 0x01, 0x0a, 0x0f, 0x44, 0x72, 0x61, 0x77, 0x41, 0x78, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01,
+	0x77, 0x12, 0x0c, 0x0a, 0x01, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x68, 0x12,
+	0x17, 0x0a, 0x07, 0x78, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x78, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x79, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x79, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x44, 0x72, 0x61, 0x77, 0x41,
+	0x78, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73, 0x0a, 0x0f, 0x44,
+	0x72, 0x61, 0x77, 0x44, 0x50, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x63, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x22, 0x12, 0x0a, 0x10, 0x44, 0x72, 0x61, 0x77, 0x44, 0x50, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x14, 0x44, 0x72, 0x61, 0x77, 0x4b, 0x65, 0x79,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12,
+	0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x6c,
+	0x5f, 0x67, 0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x47,
+	0x61, 0x70, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x72, 0x61, 0x77, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x06,
+	0x44, 0x72, 0x61, 0x77, 0x4f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x01, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x78, 0x32, 0x12, 0x0e, 0x0a, 0x02, 0x79, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x79, 0x32, 0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x77,
+	0x12, 0x0c, 0x0a, 0x01, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0x5c, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x03, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x4f, 0x70, 0x52, 0x03, 0x6f, 0x70, 0x73,
+	0x22, 0x0f, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x46, 0x0a, 0x16, 0x44, 0x72, 0x61, 0x77, 0x54, 0x65, 0x73, 0x74, 0x50, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x72, 0x61,
+	0x77, 0x54, 0x65, 0x73, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x16, 0x44, 0x72, 0x61, 0x77, 0x43, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x72, 0x61, 0x77, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x80, 0x01,
+	0x0a, 0x14, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x13,
+	0x0a, 0x05, 0x73, 0x72, 0x63, 0x5f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73,
+	0x72, 0x63, 0x57, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x72, 0x63, 0x5f, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x72, 0x63, 0x48, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x22, 0x17, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x63, 0x61, 0x6c, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x41, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01,
+	0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22,
+	0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x02, 0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x01, 0x77, 0x12, 0x0c, 0x0a, 0x01, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x01, 0x68, 0x22, 0x27, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x27, 0x0a, 0x11,
+	0x52, 0x65, 0x61, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x42, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x72, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01,
+	0x77, 0x12, 0x0c, 0x0a, 0x01, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x68, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x53,
+	0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x53, 0x63,
+	0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x0a, 0x0e, 0x53,
+	0x65, 0x74, 0x46, 0x6f, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6f, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x6f, 0x6e, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x46, 0x6f, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3e, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x22,
+	0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x4f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6e, 0x22,
+	0x16, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x2c, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2d,
+	0x0a, 0x17, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x0a,
+	0x18, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x21, 0x0a, 0x0b, 0x55, 0x6e, 0x64,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x0e, 0x0a, 0x0c,
+	0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x0a, 0x0b,
+	0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x0e, 0x0a, 0x0c, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x24, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x0f, 0x0a, 0x0d,
+	0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x0a,
+	0x0c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x44, 0x0a,
+	0x11, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x32, 0xee, 0x5c, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xbb, 0x01, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x22, 0x38, 0x2f, 0x62, 0x69, 0x6f,
+	0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0xc8, 0x01, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x4c, 0x45, 0x12, 0x36, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x4c, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x37, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x4c,
+	0x45, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x3e, 0x22, 0x3c, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x6c, 0x65, 0x12,
+	0xd0, 0x01, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x44, 0x69, 0x72, 0x74, 0x79, 0x12, 0x38, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x44, 0x69, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x39, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x44, 0x69, 0x72,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x40, 0x22, 0x3e, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x64, 0x69, 0x72,
+	0x74, 0x79, 0x12, 0xb7, 0x01, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x32, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x39, 0x22, 0x37, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0xd0, 0x01, 0x0a,
+	0x11, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x12, 0x38, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x22,
+	0x3e, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12,
+	0xd0, 0x01, 0x0a, 0x11, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x61, 0x6c, 0x65, 0x64, 0x12, 0x38, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x39, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x61, 0x6c,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x40, 0x22, 0x3e, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x64, 0x12, 0xd0, 0x01, 0x0a, 0x11, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x38, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x39, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x40, 0x22, 0x3e, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0xd4, 0x01, 0x0a, 0x12, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x39, 0x2e, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x22, 0x3f, 0x2f, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0xd4, 0x01, 0x0a,
+	0x12, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x39, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a,
+	0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x41, 0x22, 0x3f, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0xd8, 0x01, 0x0a, 0x13, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x3a, 0x2e, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x42, 0x22, 0x40, 0x2f, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0xd9,
+	0x01, 0x0a, 0x13, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6c,
+	0x6f, 0x6e, 0x67, 0x41, 0x72, 0x63, 0x12, 0x3a, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x41, 0x6c, 0x6f, 0x6e, 0x67, 0x41, 0x72, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41,
+	0x6c, 0x6f, 0x6e, 0x67, 0x41, 0x72, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x22, 0x41, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x61, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x61, 0x72, 0x63, 0x12, 0xd8, 0x01, 0x0a, 0x13, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x6e, 0x6f, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x12, 0x3a, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b,
+	0x6e, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b,
+	0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x6e, 0x6f, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x42, 0x22, 0x40, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x6e, 0x6f,
+	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0xb3, 0x01, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c,
+	0x54, 0x65, 0x78, 0x74, 0x12, 0x31, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x65, 0x78, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x54,
+	0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x38, 0x22, 0x36, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x73, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x12, 0xb7, 0x01, 0x0a, 0x0b,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x12, 0x32, 0x2e, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x33, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x22, 0x37, 0x2f, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73,
+	0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x12, 0xb3, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x63,
+	0x72, 0x6f, 0x6c, 0x6c, 0x12, 0x31, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x63, 0x72,
+	0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x38, 0x22, 0x36, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x73, 0x74, 0x6f, 0x70, 0x5f, 0x73, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x12, 0xbf, 0x01, 0x0a, 0x0d,
+	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x2e,
+	0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x3b, 0x22, 0x39, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0xa7, 0x01,
+	0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x2e, 0x2e, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x35, 0x22, 0x33, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6c,
+	0x6f, 0x67, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0xaf, 0x01, 0x0a, 0x09, 0x44, 0x72, 0x61, 0x77,
+	0x50, 0x69, 0x78, 0x65, 0x6c, 0x12, 0x30, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x50, 0x69, 0x78, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x50, 0x69, 0x78,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x37, 0x22, 0x35, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64,
+	0x72, 0x61, 0x77, 0x5f, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x12, 0xb3, 0x01, 0x0a, 0x0a, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x12, 0x31, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x50,
+	0x69, 0x78, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x22, 0x36, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x12,
+	0xab, 0x01, 0x0a, 0x08, 0x44, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x2f, 0x2e, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72,
+	0x61, 0x77, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x72, 0x61, 0x77, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x22, 0x34, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0xab, 0x01,
+	0x0a, 0x08, 0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x63, 0x74, 0x12, 0x2f, 0x2e, 0x62, 0x69, 0x6f,
+	0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77,
+	0x52, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61,
+	0x77, 0x52, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x36, 0x22, 0x34, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x63, 0x74, 0x12, 0xab, 0x01, 0x0a, 0x08,
+	0x46, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x63, 0x74, 0x12, 0x2f, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x52, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x52,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x36, 0x22, 0x34, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x74, 0x12, 0xb3, 0x01, 0x0a, 0x0a, 0x44, 0x72,
+	0x61, 0x77, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x12, 0x31, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x43, 0x69,
+	0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61,
+	0x77, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x22, 0x36, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x12,
+	0xb3, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x6c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x12, 0x31,
+	0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x6c, 0x6c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x22, 0x36, 0x2f,
+	0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x63,
+	0x69, 0x72, 0x63, 0x6c, 0x65, 0x12, 0xb7, 0x01, 0x0a, 0x0b, 0x44, 0x72, 0x61, 0x77, 0x45, 0x6c,
+	0x6c, 0x69, 0x70, 0x73, 0x65, 0x12, 0x32, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x45, 0x6c, 0x6c, 0x69, 0x70,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x45,
+	0x6c, 0x6c, 0x69, 0x70, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x22, 0x37, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b,
+	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x73, 0x65, 0x12,
+	0xb7, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x6c, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x73, 0x65, 0x12,
+	0x32, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x45, 0x6c, 0x6c, 0x69, 0x70, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39,
+	0x22, 0x37, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x66, 0x69, 0x6c,
+	0x6c, 0x5f, 0x65, 0x6c, 0x6c, 0x69, 0x70, 0x73, 0x65, 0x12, 0xc0, 0x01, 0x0a, 0x0d, 0x44, 0x72,
+	0x61, 0x77, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x74, 0x12, 0x34, 0x2e, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61,
+	0x77, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x35, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c,
+	0x22, 0x3a, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61,
+	0x77, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x74, 0x12, 0xc0, 0x01, 0x0a,
+	0x0d, 0x46, 0x69, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x74, 0x12, 0x34,
+	0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x3c, 0x22, 0x3a, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x74, 0x12,
+	0xbb, 0x01, 0x0a, 0x0c, 0x44, 0x72, 0x61, 0x77, 0x54, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65,
+	0x12, 0x33, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x54, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x54, 0x72, 0x69, 0x61, 0x6e,
+	0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x3a, 0x22, 0x38, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x64, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0xbb, 0x01,
+	0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x6c, 0x54, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x33,
+	0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x6c, 0x6c, 0x54, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x54, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x3a, 0x22, 0x38, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x66, 0x69,
+	0x6c, 0x6c, 0x5f, 0x74, 0x72, 0x69, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0xb3, 0x01, 0x0a, 0x0a,
+	0x44, 0x72, 0x61, 0x77, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x31, 0x2e, 0x62, 0x69, 0x6f,
+	0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77,
+	0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
+	0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x72, 0x61, 0x77, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x22, 0x36, 0x2f, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0xab, 0x01, 0x0a, 0x08, 0x44, 0x72, 0x61, 0x77, 0x47, 0x72, 0x69, 0x64, 0x12, 0x2f,
+	0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x72, 0x61, 0x77, 0x47, 0x72, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x30, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x72, 0x61, 0x77, 0x47, 0x72, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x22, 0x34, 0x2f, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x67, 0x72, 0x69, 0x64, 0x12,
+	0xbf, 0x01, 0x0a, 0x0d, 0x44, 0x72, 0x61, 0x77, 0x57, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c,
+	0x6c, 0x12, 0x34, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x57, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x57, 0x61, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x22, 0x39, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b,
+	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c,
+	0x6c, 0x12, 0xc8, 0x01, 0x0a, 0x0f, 0x44, 0x72, 0x61, 0x77, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e,
+	0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x72, 0x61, 0x77, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x22, 0x3c,
+	0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0xb3, 0x01, 0x0a,
+	0x0a, 0x44, 0x72, 0x61, 0x77, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x31, 0x2e, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61,
+	0x77, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x72, 0x61, 0x77, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x22, 0x36, 0x2f, 0x62, 0x69, 0x6f,
+	0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x69, 0x74, 0x6d,
+	0x61, 0x70, 0x12, 0xd4, 0x01, 0x0a, 0x12, 0x44, 0x72, 0x61, 0x77, 0x42, 0x69, 0x74, 0x6d, 0x61,
+	0x70, 0x44, 0x69, 0x74, 0x68, 0x65, 0x72, 0x65, 0x64, 0x12, 0x39, 0x2e, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x42,
+	0x69, 0x74, 0x6d, 0x61, 0x70, 0x44, 0x69, 0x74, 0x68, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70,
+	0x44, 0x69, 0x74, 0x68, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x22, 0x3f, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70,
+	0x5f, 0x64, 0x69, 0x74, 0x68, 0x65, 0x72, 0x65, 0x64, 0x12, 0xc0, 0x01, 0x0a, 0x0d, 0x44, 0x72,
+	0x61, 0x77, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x4c, 0x45, 0x12, 0x34, 0x2e, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61,
+	0x77, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x4c, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x35, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x4c, 0x45,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c,
+	0x22, 0x3a, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61,
+	0x77, 0x5f, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x5f, 0x72, 0x6c, 0x65, 0x12, 0xe5, 0x01, 0x0a,
+	0x16, 0x44, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x42, 0x61, 0x72,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x65, 0x64, 0x12, 0x3d, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x42, 0x61, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x42, 0x61, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x46, 0x22, 0x44,
+	0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x72, 0x5f, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x65, 0x64, 0x12, 0xab, 0x01, 0x0a, 0x08, 0x44, 0x72, 0x61, 0x77, 0x41, 0x78, 0x65,
+	0x73, 0x12, 0x2f, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x41, 0x78, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x41, 0x78, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x22, 0x34, 0x2f, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x78,
+	0x65, 0x73, 0x12, 0xac, 0x01, 0x0a, 0x08, 0x44, 0x72, 0x61, 0x77, 0x44, 0x50, 0x61, 0x64, 0x12,
+	0x2f, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x72, 0x61, 0x77, 0x44, 0x50, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x30, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x44, 0x50, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x22, 0x35, 0x2f, 0x62, 0x69, 0x6f,
+	0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x64, 0x5f, 0x70, 0x61,
+	0x64, 0x12, 0xc0, 0x01, 0x0a, 0x0d, 0x44, 0x72, 0x61, 0x77, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x4b,
+	0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x22, 0x3a, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x9e, 0x01, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2c,
+	0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x32, 0x22, 0x30, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0xc8, 0x01, 0x0a, 0x0f, 0x44, 0x72, 0x61, 0x77, 0x54, 0x65,
+	0x73, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x36, 0x2e, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x54,
+	0x65, 0x73, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x37, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x54, 0x65, 0x73, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x3e, 0x22, 0x3c, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64,
+	0x72, 0x61, 0x77, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x12, 0xc7, 0x01, 0x0a, 0x0f, 0x44, 0x72, 0x61, 0x77, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72,
+	0x61, 0x77, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x22, 0x3b, 0x2f,
+	0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x63,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xbf, 0x01, 0x0a, 0x0d, 0x44,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x64, 0x12, 0x34, 0x2e, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x35, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x63, 0x61, 0x6c, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x3b, 0x22, 0x39, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x64, 0x12, 0xbf, 0x01, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34,
+	0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x3b, 0x22, 0x39, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x67, 0x65, 0x74, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xab,
+	0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x12, 0x2f, 0x2e, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x69, 0x78, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x22, 0x34, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b,
+	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x12, 0xaf, 0x01, 0x0a,
+	0x09, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x62, 0x69, 0x6f,
+	0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x22, 0x35, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0xb3,
+	0x01, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x31, 0x2e,
+	0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x22, 0x36, 0x2f, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x12, 0xaf, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x22,
+	0x35, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x5f,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0xab, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x53, 0x63,
+	0x61, 0x6c, 0x65, 0x12, 0x2f, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x22, 0x34,
+	0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x5f, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x12, 0xa7, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x46, 0x6f, 0x6e, 0x74,
+	0x12, 0x2e, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x6f, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x6f, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x22, 0x33, 0x2f, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x6f, 0x6e, 0x74, 0x12, 0xb7,
+	0x01, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x73, 0x74, 0x12, 0x32,
+	0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x22,
+	0x37, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x73, 0x74, 0x12, 0xaf, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x74,
+	0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0x30, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x37, 0x22, 0x35, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0xbc, 0x01, 0x0a, 0x0c, 0x53,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x6e, 0x12, 0x33, 0x2e, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x34, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x22, 0x39,
+	0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x5f, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6f, 0x6e, 0x12, 0xb3, 0x01, 0x0a, 0x0a, 0x52, 0x65,
+	0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x22, 0x36, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0xcb, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x37, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e,
+	0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x22,
+	0x3c, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x9a, 0x01,
+	0x0a, 0x04, 0x55, 0x6e, 0x64, 0x6f, 0x12, 0x2b, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22, 0x2f, 0x2f, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x75, 0x6e, 0x64, 0x6f, 0x12, 0x9a, 0x01, 0x0a, 0x04, 0x52,
+	0x65, 0x64, 0x6f, 0x12, 0x2b, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22, 0x2f, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b,
+	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x72, 0x65, 0x64, 0x6f, 0x12, 0xa6, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x12, 0x2e, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x22, 0x32, 0x2f, 0x62,
+	0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x12, 0x9e, 0x01, 0x0a, 0x05, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x2c, 0x2e, 0x62, 0x69, 0x6f,
+	0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22,
+	0x30, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x12, 0x9e, 0x01, 0x0a, 0x05, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x2e, 0x62, 0x69,
+	0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32,
+	0x22, 0x30, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x12, 0xaf, 0x01, 0x0a, 0x09, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x30, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x22, 0x35, 0x2f,
+	0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x6f, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x76, 0x69, 0x61,
+	0x6d, 0x2d, 0x69, 0x32, 0x63, 0x2d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_component_display_v1_display_proto_rawDescOnce sync.Once
+	file_component_display_v1_display_proto_rawDescData = file_component_display_v1_display_proto_rawDesc
+)
+
+func file_component_display_v1_display_proto_rawDescGZIP() []byte {
+	file_component_display_v1_display_proto_rawDescOnce.Do(func() {
+		file_component_display_v1_display_proto_rawDescData = protoimpl.X.CompressGZIP(file_component_display_v1_display_proto_rawDescData)
+	})
+	return file_component_display_v1_display_proto_rawDescData
+}
+
+var file_component_display_v1_display_proto_msgTypes = make([]protoimpl.MessageInfo, 127)
+var file_component_display_v1_display_proto_goTypes = []interface{}{
+	(*DisplayBytesRequest)(nil),            // 0: biotinker.component.display.v1.DisplayBytesRequest
+	(*DisplayBytesResponse)(nil),           // 1: biotinker.component.display.v1.DisplayBytesResponse
+	(*DisplayBytesRLERequest)(nil),         // 2: biotinker.component.display.v1.DisplayBytesRLERequest
+	(*DisplayBytesRLEResponse)(nil),        // 3: biotinker.component.display.v1.DisplayBytesRLEResponse
+	(*DisplayBytesDirtyRequest)(nil),       // 4: biotinker.component.display.v1.DisplayBytesDirtyRequest
+	(*DisplayBytesDirtyResponse)(nil),      // 5: biotinker.component.display.v1.DisplayBytesDirtyResponse
+	(*WriteStringRequest)(nil),             // 6: biotinker.component.display.v1.WriteStringRequest
+	(*WriteStringResponse)(nil),            // 7: biotinker.component.display.v1.WriteStringResponse
+	(*WriteStringDoubleRequest)(nil),       // 8: biotinker.component.display.v1.WriteStringDoubleRequest
+	(*WriteStringDoubleResponse)(nil),      // 9: biotinker.component.display.v1.WriteStringDoubleResponse
+	(*WriteStringScaledRequest)(nil),       // 10: biotinker.component.display.v1.WriteStringScaledRequest
+	(*WriteStringScaledResponse)(nil),      // 11: biotinker.component.display.v1.WriteStringScaledResponse
+	(*WriteStringCursorRequest)(nil),       // 12: biotinker.component.display.v1.WriteStringCursorRequest
+	(*WriteStringCursorResponse)(nil),      // 13: biotinker.component.display.v1.WriteStringCursorResponse
+	(*WriteStringWrappedRequest)(nil),      // 14: biotinker.component.display.v1.WriteStringWrappedRequest
+	(*WriteStringWrappedResponse)(nil),     // 15: biotinker.component.display.v1.WriteStringWrappedResponse
+	(*WriteStringRotatedRequest)(nil),      // 16: biotinker.component.display.v1.WriteStringRotatedRequest
+	(*WriteStringRotatedResponse)(nil),     // 17: biotinker.component.display.v1.WriteStringRotatedResponse
+	(*WriteStringVerticalRequest)(nil),     // 18: biotinker.component.display.v1.WriteStringVerticalRequest
+	(*WriteStringVerticalResponse)(nil),    // 19: biotinker.component.display.v1.WriteStringVerticalResponse
+	(*WriteStringAlongArcRequest)(nil),     // 20: biotinker.component.display.v1.WriteStringAlongArcRequest
+	(*WriteStringAlongArcResponse)(nil),    // 21: biotinker.component.display.v1.WriteStringAlongArcResponse
+	(*WriteStringKnockoutRequest)(nil),     // 22: biotinker.component.display.v1.WriteStringKnockoutRequest
+	(*WriteStringKnockoutResponse)(nil),    // 23: biotinker.component.display.v1.WriteStringKnockoutResponse
+	(*ScrollTextRequest)(nil),              // 24: biotinker.component.display.v1.ScrollTextRequest
+	(*ScrollTextResponse)(nil),             // 25: biotinker.component.display.v1.ScrollTextResponse
+	(*StartScrollRequest)(nil),             // 26: biotinker.component.display.v1.StartScrollRequest
+	(*StartScrollResponse)(nil),            // 27: biotinker.component.display.v1.StartScrollResponse
+	(*StopScrollRequest)(nil),              // 28: biotinker.component.display.v1.StopScrollRequest
+	(*StopScrollResponse)(nil),             // 29: biotinker.component.display.v1.StopScrollResponse
+	(*MeasureStringRequest)(nil),           // 30: biotinker.component.display.v1.MeasureStringRequest
+	(*MeasureStringResponse)(nil),          // 31: biotinker.component.display.v1.MeasureStringResponse
+	(*LogLineRequest)(nil),                 // 32: biotinker.component.display.v1.LogLineRequest
+	(*LogLineResponse)(nil),                // 33: biotinker.component.display.v1.LogLineResponse
+	(*DrawPixelRequest)(nil),               // 34: biotinker.component.display.v1.DrawPixelRequest
+	(*DrawPixelResponse)(nil),              // 35: biotinker.component.display.v1.DrawPixelResponse
+	(*ClearPixelRequest)(nil),              // 36: biotinker.component.display.v1.ClearPixelRequest
+	(*ClearPixelResponse)(nil),             // 37: biotinker.component.display.v1.ClearPixelResponse
+	(*DrawLineRequest)(nil),                // 38: biotinker.component.display.v1.DrawLineRequest
+	(*DrawLineResponse)(nil),               // 39: biotinker.component.display.v1.DrawLineResponse
+	(*DrawRectRequest)(nil),                // 40: biotinker.component.display.v1.DrawRectRequest
+	(*DrawRectResponse)(nil),               // 41: biotinker.component.display.v1.DrawRectResponse
+	(*FillRectRequest)(nil),                // 42: biotinker.component.display.v1.FillRectRequest
+	(*FillRectResponse)(nil),               // 43: biotinker.component.display.v1.FillRectResponse
+	(*DrawCircleRequest)(nil),              // 44: biotinker.component.display.v1.DrawCircleRequest
+	(*DrawCircleResponse)(nil),             // 45: biotinker.component.display.v1.DrawCircleResponse
+	(*FillCircleRequest)(nil),              // 46: biotinker.component.display.v1.FillCircleRequest
+	(*FillCircleResponse)(nil),             // 47: biotinker.component.display.v1.FillCircleResponse
+	(*DrawEllipseRequest)(nil),             // 48: biotinker.component.display.v1.DrawEllipseRequest
+	(*DrawEllipseResponse)(nil),            // 49: biotinker.component.display.v1.DrawEllipseResponse
+	(*FillEllipseRequest)(nil),             // 50: biotinker.component.display.v1.FillEllipseRequest
+	(*FillEllipseResponse)(nil),            // 51: biotinker.component.display.v1.FillEllipseResponse
+	(*DrawRoundRectRequest)(nil),           // 52: biotinker.component.display.v1.DrawRoundRectRequest
+	(*DrawRoundRectResponse)(nil),          // 53: biotinker.component.display.v1.DrawRoundRectResponse
+	(*FillRoundRectRequest)(nil),           // 54: biotinker.component.display.v1.FillRoundRectRequest
+	(*FillRoundRectResponse)(nil),          // 55: biotinker.component.display.v1.FillRoundRectResponse
+	(*DrawTriangleRequest)(nil),            // 56: biotinker.component.display.v1.DrawTriangleRequest
+	(*DrawTriangleResponse)(nil),           // 57: biotinker.component.display.v1.DrawTriangleResponse
+	(*FillTriangleRequest)(nil),            // 58: biotinker.component.display.v1.FillTriangleRequest
+	(*FillTriangleResponse)(nil),           // 59: biotinker.component.display.v1.FillTriangleResponse
+	(*DrawBorderRequest)(nil),              // 60: biotinker.component.display.v1.DrawBorderRequest
+	(*DrawBorderResponse)(nil),             // 61: biotinker.component.display.v1.DrawBorderResponse
+	(*DrawGridRequest)(nil),                // 62: biotinker.component.display.v1.DrawGridRequest
+	(*DrawGridResponse)(nil),               // 63: biotinker.component.display.v1.DrawGridResponse
+	(*DrawWaterfallRequest)(nil),           // 64: biotinker.component.display.v1.DrawWaterfallRequest
+	(*DrawWaterfallResponse)(nil),          // 65: biotinker.component.display.v1.DrawWaterfallResponse
+	(*DrawImageRegionRequest)(nil),         // 66: biotinker.component.display.v1.DrawImageRegionRequest
+	(*DrawImageRegionResponse)(nil),        // 67: biotinker.component.display.v1.DrawImageRegionResponse
+	(*DrawBitmapRequest)(nil),              // 68: biotinker.component.display.v1.DrawBitmapRequest
+	(*DrawBitmapResponse)(nil),             // 69: biotinker.component.display.v1.DrawBitmapResponse
+	(*DrawBitmapDitheredRequest)(nil),      // 70: biotinker.component.display.v1.DrawBitmapDitheredRequest
+	(*DrawBitmapDitheredResponse)(nil),     // 71: biotinker.component.display.v1.DrawBitmapDitheredResponse
+	(*DrawBitmapRLERequest)(nil),           // 72: biotinker.component.display.v1.DrawBitmapRLERequest
+	(*DrawBitmapRLEResponse)(nil),          // 73: biotinker.component.display.v1.DrawBitmapRLEResponse
+	(*DrawProgressBarLabeledRequest)(nil),  // 74: biotinker.component.display.v1.DrawProgressBarLabeledRequest
+	(*DrawProgressBarLabeledResponse)(nil), // 75: biotinker.component.display.v1.DrawProgressBarLabeledResponse
+	(*DrawAxesRequest)(nil),                // 76: biotinker.component.display.v1.DrawAxesRequest
+	(*DrawAxesResponse)(nil),               // 77: biotinker.component.display.v1.DrawAxesResponse
+	(*DrawDPadRequest)(nil),                // 78: biotinker.component.display.v1.DrawDPadRequest
+	(*DrawDPadResponse)(nil),               // 79: biotinker.component.display.v1.DrawDPadResponse
+	(*DrawKeyValuesRequest)(nil),           // 80: biotinker.component.display.v1.DrawKeyValuesRequest
+	(*DrawKeyValuesResponse)(nil),          // 81: biotinker.component.display.v1.DrawKeyValuesResponse
+	(*DrawOp)(nil),                         // 82: biotinker.component.display.v1.DrawOp
+	(*BatchRequest)(nil),                   // 83: biotinker.component.display.v1.BatchRequest
+	(*BatchResponse)(nil),                  // 84: biotinker.component.display.v1.BatchResponse
+	(*DrawTestPatternRequest)(nil),         // 85: biotinker.component.display.v1.DrawTestPatternRequest
+	(*DrawTestPatternResponse)(nil),        // 86: biotinker.component.display.v1.DrawTestPatternResponse
+	(*DrawCalibrationRequest)(nil),         // 87: biotinker.component.display.v1.DrawCalibrationRequest
+	(*DrawCalibrationResponse)(nil),        // 88: biotinker.component.display.v1.DrawCalibrationResponse
+	(*DisplayScaledRequest)(nil),           // 89: biotinker.component.display.v1.DisplayScaledRequest
+	(*DisplayScaledResponse)(nil),          // 90: biotinker.component.display.v1.DisplayScaledResponse
+	(*GetDimensionsRequest)(nil),           // 91: biotinker.component.display.v1.GetDimensionsRequest
+	(*GetDimensionsResponse)(nil),          // 92: biotinker.component.display.v1.GetDimensionsResponse
+	(*GetPixelRequest)(nil),                // 93: biotinker.component.display.v1.GetPixelRequest
+	(*GetPixelResponse)(nil),               // 94: biotinker.component.display.v1.GetPixelResponse
+	(*GetRegionRequest)(nil),               // 95: biotinker.component.display.v1.GetRegionRequest
+	(*GetRegionResponse)(nil),              // 96: biotinker.component.display.v1.GetRegionResponse
+	(*ReadBufferRequest)(nil),              // 97: biotinker.component.display.v1.ReadBufferRequest
+	(*ReadBufferResponse)(nil),             // 98: biotinker.component.display.v1.ReadBufferResponse
+	(*SetRegionRequest)(nil),               // 99: biotinker.component.display.v1.SetRegionRequest
+	(*SetRegionResponse)(nil),              // 100: biotinker.component.display.v1.SetRegionResponse
+	(*SetScaleRequest)(nil),                // 101: biotinker.component.display.v1.SetScaleRequest
+	(*SetScaleResponse)(nil),               // 102: biotinker.component.display.v1.SetScaleResponse
+	(*SetFontRequest)(nil),                 // 103: biotinker.component.display.v1.SetFontRequest
+	(*SetFontResponse)(nil),                // 104: biotinker.component.display.v1.SetFontResponse
+	(*SetContrastRequest)(nil),             // 105: biotinker.component.display.v1.SetContrastRequest
+	(*SetContrastResponse)(nil),            // 106: biotinker.component.display.v1.SetContrastResponse
+	(*SetInvertRequest)(nil),               // 107: biotinker.component.display.v1.SetInvertRequest
+	(*SetInvertResponse)(nil),              // 108: biotinker.component.display.v1.SetInvertResponse
+	(*SetDisplayOnRequest)(nil),            // 109: biotinker.component.display.v1.SetDisplayOnRequest
+	(*SetDisplayOnResponse)(nil),           // 110: biotinker.component.display.v1.SetDisplayOnResponse
+	(*ReadStatusRequest)(nil),              // 111: biotinker.component.display.v1.ReadStatusRequest
+	(*ReadStatusResponse)(nil),             // 112: biotinker.component.display.v1.ReadStatusResponse
+	(*DetectControllerRequest)(nil),        // 113: biotinker.component.display.v1.DetectControllerRequest
+	(*DetectControllerResponse)(nil),       // 114: biotinker.component.display.v1.DetectControllerResponse
+	(*UndoRequest)(nil),                    // 115: biotinker.component.display.v1.UndoRequest
+	(*UndoResponse)(nil),                   // 116: biotinker.component.display.v1.UndoResponse
+	(*RedoRequest)(nil),                    // 117: biotinker.component.display.v1.RedoRequest
+	(*RedoResponse)(nil),                   // 118: biotinker.component.display.v1.RedoResponse
+	(*RefreshRequest)(nil),                 // 119: biotinker.component.display.v1.RefreshRequest
+	(*RefreshResponse)(nil),                // 120: biotinker.component.display.v1.RefreshResponse
+	(*ClearRequest)(nil),                   // 121: biotinker.component.display.v1.ClearRequest
+	(*ClearResponse)(nil),                  // 122: biotinker.component.display.v1.ClearResponse
+	(*ResetRequest)(nil),                   // 123: biotinker.component.display.v1.ResetRequest
+	(*ResetResponse)(nil),                  // 124: biotinker.component.display.v1.ResetResponse
+	(*DoCommandRequest)(nil),               // 125: biotinker.component.display.v1.DoCommandRequest
+	(*DoCommandResponse)(nil),              // 126: biotinker.component.display.v1.DoCommandResponse
+	(*structpb.Struct)(nil),                // 127: google.protobuf.Struct
+}
+var file_component_display_v1_display_proto_depIdxs = []int32{
+	82,  // 0: biotinker.component.display.v1.BatchRequest.ops:type_name -> biotinker.component.display.v1.DrawOp
+	127, // 1: biotinker.component.display.v1.DoCommandRequest.command:type_name -> google.protobuf.Struct
+	127, // 2: biotinker.component.display.v1.DoCommandResponse.result:type_name -> google.protobuf.Struct
+	0,   // 3: biotinker.component.display.v1.DisplayService.DisplayBytes:input_type -> biotinker.component.display.v1.DisplayBytesRequest
+	2,   // 4: biotinker.component.display.v1.DisplayService.DisplayBytesRLE:input_type -> biotinker.component.display.v1.DisplayBytesRLERequest
+	4,   // 5: biotinker.component.display.v1.DisplayService.DisplayBytesDirty:input_type -> biotinker.component.display.v1.DisplayBytesDirtyRequest
+	6,   // 6: biotinker.component.display.v1.DisplayService.WriteString:input_type -> biotinker.component.display.v1.WriteStringRequest
+	8,   // 7: biotinker.component.display.v1.DisplayService.WriteStringDouble:input_type -> biotinker.component.display.v1.WriteStringDoubleRequest
+	10,  // 8: biotinker.component.display.v1.DisplayService.WriteStringScaled:input_type -> biotinker.component.display.v1.WriteStringScaledRequest
+	12,  // 9: biotinker.component.display.v1.DisplayService.WriteStringCursor:input_type -> biotinker.component.display.v1.WriteStringCursorRequest
+	14,  // 10: biotinker.component.display.v1.DisplayService.WriteStringWrapped:input_type -> biotinker.component.display.v1.WriteStringWrappedRequest
+	16,  // 11: biotinker.component.display.v1.DisplayService.WriteStringRotated:input_type -> biotinker.component.display.v1.WriteStringRotatedRequest
+	18,  // 12: biotinker.component.display.v1.DisplayService.WriteStringVertical:input_type -> biotinker.component.display.v1.WriteStringVerticalRequest
+	20,  // 13: biotinker.component.display.v1.DisplayService.WriteStringAlongArc:input_type -> biotinker.component.display.v1.WriteStringAlongArcRequest
+	22,  // 14: biotinker.component.display.v1.DisplayService.WriteStringKnockout:input_type -> biotinker.component.display.v1.WriteStringKnockoutRequest
+	24,  // 15: biotinker.component.display.v1.DisplayService.ScrollText:input_type -> biotinker.component.display.v1.ScrollTextRequest
+	26,  // 16: biotinker.component.display.v1.DisplayService.StartScroll:input_type -> biotinker.component.display.v1.StartScrollRequest
+	28,  // 17: biotinker.component.display.v1.DisplayService.StopScroll:input_type -> biotinker.component.display.v1.StopScrollRequest
+	30,  // 18: biotinker.component.display.v1.DisplayService.MeasureString:input_type -> biotinker.component.display.v1.MeasureStringRequest
+	32,  // 19: biotinker.component.display.v1.DisplayService.LogLine:input_type -> biotinker.component.display.v1.LogLineRequest
+	34,  // 20: biotinker.component.display.v1.DisplayService.DrawPixel:input_type -> biotinker.component.display.v1.DrawPixelRequest
+	36,  // 21: biotinker.component.display.v1.DisplayService.ClearPixel:input_type -> biotinker.component.display.v1.ClearPixelRequest
+	38,  // 22: biotinker.component.display.v1.DisplayService.DrawLine:input_type -> biotinker.component.display.v1.DrawLineRequest
+	40,  // 23: biotinker.component.display.v1.DisplayService.DrawRect:input_type -> biotinker.component.display.v1.DrawRectRequest
+	42,  // 24: biotinker.component.display.v1.DisplayService.FillRect:input_type -> biotinker.component.display.v1.FillRectRequest
+	44,  // 25: biotinker.component.display.v1.DisplayService.DrawCircle:input_type -> biotinker.component.display.v1.DrawCircleRequest
+	46,  // 26: biotinker.component.display.v1.DisplayService.FillCircle:input_type -> biotinker.component.display.v1.FillCircleRequest
+	48,  // 27: biotinker.component.display.v1.DisplayService.DrawEllipse:input_type -> biotinker.component.display.v1.DrawEllipseRequest
+	50,  // 28: biotinker.component.display.v1.DisplayService.FillEllipse:input_type -> biotinker.component.display.v1.FillEllipseRequest
+	52,  // 29: biotinker.component.display.v1.DisplayService.DrawRoundRect:input_type -> biotinker.component.display.v1.DrawRoundRectRequest
+	54,  // 30: biotinker.component.display.v1.DisplayService.FillRoundRect:input_type -> biotinker.component.display.v1.FillRoundRectRequest
+	56,  // 31: biotinker.component.display.v1.DisplayService.DrawTriangle:input_type -> biotinker.component.display.v1.DrawTriangleRequest
+	58,  // 32: biotinker.component.display.v1.DisplayService.FillTriangle:input_type -> biotinker.component.display.v1.FillTriangleRequest
+	60,  // 33: biotinker.component.display.v1.DisplayService.DrawBorder:input_type -> biotinker.component.display.v1.DrawBorderRequest
+	62,  // 34: biotinker.component.display.v1.DisplayService.DrawGrid:input_type -> biotinker.component.display.v1.DrawGridRequest
+	64,  // 35: biotinker.component.display.v1.DisplayService.DrawWaterfall:input_type -> biotinker.component.display.v1.DrawWaterfallRequest
+	66,  // 36: biotinker.component.display.v1.DisplayService.DrawImageRegion:input_type -> biotinker.component.display.v1.DrawImageRegionRequest
+	68,  // 37: biotinker.component.display.v1.DisplayService.DrawBitmap:input_type -> biotinker.component.display.v1.DrawBitmapRequest
+	70,  // 38: biotinker.component.display.v1.DisplayService.DrawBitmapDithered:input_type -> biotinker.component.display.v1.DrawBitmapDitheredRequest
+	72,  // 39: biotinker.component.display.v1.DisplayService.DrawBitmapRLE:input_type -> biotinker.component.display.v1.DrawBitmapRLERequest
+	74,  // 40: biotinker.component.display.v1.DisplayService.DrawProgressBarLabeled:input_type -> biotinker.component.display.v1.DrawProgressBarLabeledRequest
+	76,  // 41: biotinker.component.display.v1.DisplayService.DrawAxes:input_type -> biotinker.component.display.v1.DrawAxesRequest
+	78,  // 42: biotinker.component.display.v1.DisplayService.DrawDPad:input_type -> biotinker.component.display.v1.DrawDPadRequest
+	80,  // 43: biotinker.component.display.v1.DisplayService.DrawKeyValues:input_type -> biotinker.component.display.v1.DrawKeyValuesRequest
+	83,  // 44: biotinker.component.display.v1.DisplayService.Batch:input_type -> biotinker.component.display.v1.BatchRequest
+	85,  // 45: biotinker.component.display.v1.DisplayService.DrawTestPattern:input_type -> biotinker.component.display.v1.DrawTestPatternRequest
+	87,  // 46: biotinker.component.display.v1.DisplayService.DrawCalibration:input_type -> biotinker.component.display.v1.DrawCalibrationRequest
+	89,  // 47: biotinker.component.display.v1.DisplayService.DisplayScaled:input_type -> biotinker.component.display.v1.DisplayScaledRequest
+	91,  // 48: biotinker.component.display.v1.DisplayService.GetDimensions:input_type -> biotinker.component.display.v1.GetDimensionsRequest
+	93,  // 49: biotinker.component.display.v1.DisplayService.GetPixel:input_type -> biotinker.component.display.v1.GetPixelRequest
+	95,  // 50: biotinker.component.display.v1.DisplayService.GetRegion:input_type -> biotinker.component.display.v1.GetRegionRequest
+	97,  // 51: biotinker.component.display.v1.DisplayService.ReadBuffer:input_type -> biotinker.component.display.v1.ReadBufferRequest
+	99,  // 52: biotinker.component.display.v1.DisplayService.SetRegion:input_type -> biotinker.component.display.v1.SetRegionRequest
+	101, // 53: biotinker.component.display.v1.DisplayService.SetScale:input_type -> biotinker.component.display.v1.SetScaleRequest
+	103, // 54: biotinker.component.display.v1.DisplayService.SetFont:input_type -> biotinker.component.display.v1.SetFontRequest
+	105, // 55: biotinker.component.display.v1.DisplayService.SetContrast:input_type -> biotinker.component.display.v1.SetContrastRequest
+	107, // 56: biotinker.component.display.v1.DisplayService.SetInvert:input_type -> biotinker.component.display.v1.SetInvertRequest
+	109, // 57: biotinker.component.display.v1.DisplayService.SetDisplayOn:input_type -> biotinker.component.display.v1.SetDisplayOnRequest
+	111, // 58: biotinker.component.display.v1.DisplayService.ReadStatus:input_type -> biotinker.component.display.v1.ReadStatusRequest
+	113, // 59: biotinker.component.display.v1.DisplayService.DetectController:input_type -> biotinker.component.display.v1.DetectControllerRequest
+	115, // 60: biotinker.component.display.v1.DisplayService.Undo:input_type -> biotinker.component.display.v1.UndoRequest
+	117, // 61: biotinker.component.display.v1.DisplayService.Redo:input_type -> biotinker.component.display.v1.RedoRequest
+	119, // 62: biotinker.component.display.v1.DisplayService.Refresh:input_type -> biotinker.component.display.v1.RefreshRequest
+	121, // 63: biotinker.component.display.v1.DisplayService.Clear:input_type -> biotinker.component.display.v1.ClearRequest
+	123, // 64: biotinker.component.display.v1.DisplayService.Reset:input_type -> biotinker.component.display.v1.ResetRequest
+	125, // 65: biotinker.component.display.v1.DisplayService.DoCommand:input_type -> biotinker.component.display.v1.DoCommandRequest
+	1,   // 66: biotinker.component.display.v1.DisplayService.DisplayBytes:output_type -> biotinker.component.display.v1.DisplayBytesResponse
+	3,   // 67: biotinker.component.display.v1.DisplayService.DisplayBytesRLE:output_type -> biotinker.component.display.v1.DisplayBytesRLEResponse
+	5,   // 68: biotinker.component.display.v1.DisplayService.DisplayBytesDirty:output_type -> biotinker.component.display.v1.DisplayBytesDirtyResponse
+	7,   // 69: biotinker.component.display.v1.DisplayService.WriteString:output_type -> biotinker.component.display.v1.WriteStringResponse
+	9,   // 70: biotinker.component.display.v1.DisplayService.WriteStringDouble:output_type -> biotinker.component.display.v1.WriteStringDoubleResponse
+	11,  // 71: biotinker.component.display.v1.DisplayService.WriteStringScaled:output_type -> biotinker.component.display.v1.WriteStringScaledResponse
+	13,  // 72: biotinker.component.display.v1.DisplayService.WriteStringCursor:output_type -> biotinker.component.display.v1.WriteStringCursorResponse
+	15,  // 73: biotinker.component.display.v1.DisplayService.WriteStringWrapped:output_type -> biotinker.component.display.v1.WriteStringWrappedResponse
+	17,  // 74: biotinker.component.display.v1.DisplayService.WriteStringRotated:output_type -> biotinker.component.display.v1.WriteStringRotatedResponse
+	19,  // 75: biotinker.component.display.v1.DisplayService.WriteStringVertical:output_type -> biotinker.component.display.v1.WriteStringVerticalResponse
+	21,  // 76: biotinker.component.display.v1.DisplayService.WriteStringAlongArc:output_type -> biotinker.component.display.v1.WriteStringAlongArcResponse
+	23,  // 77: biotinker.component.display.v1.DisplayService.WriteStringKnockout:output_type -> biotinker.component.display.v1.WriteStringKnockoutResponse
+	25,  // 78: biotinker.component.display.v1.DisplayService.ScrollText:output_type -> biotinker.component.display.v1.ScrollTextResponse
+	27,  // 79: biotinker.component.display.v1.DisplayService.StartScroll:output_type -> biotinker.component.display.v1.StartScrollResponse
+	29,  // 80: biotinker.component.display.v1.DisplayService.StopScroll:output_type -> biotinker.component.display.v1.StopScrollResponse
+	31,  // 81: biotinker.component.display.v1.DisplayService.MeasureString:output_type -> biotinker.component.display.v1.MeasureStringResponse
+	33,  // 82: biotinker.component.display.v1.DisplayService.LogLine:output_type -> biotinker.component.display.v1.LogLineResponse
+	35,  // 83: biotinker.component.display.v1.DisplayService.DrawPixel:output_type -> biotinker.component.display.v1.DrawPixelResponse
+	37,  // 84: biotinker.component.display.v1.DisplayService.ClearPixel:output_type -> biotinker.component.display.v1.ClearPixelResponse
+	39,  // 85: biotinker.component.display.v1.DisplayService.DrawLine:output_type -> biotinker.component.display.v1.DrawLineResponse
+	41,  // 86: biotinker.component.display.v1.DisplayService.DrawRect:output_type -> biotinker.component.display.v1.DrawRectResponse
+	43,  // 87: biotinker.component.display.v1.DisplayService.FillRect:output_type -> biotinker.component.display.v1.FillRectResponse
+	45,  // 88: biotinker.component.display.v1.DisplayService.DrawCircle:output_type -> biotinker.component.display.v1.DrawCircleResponse
+	47,  // 89: biotinker.component.display.v1.DisplayService.FillCircle:output_type -> biotinker.component.display.v1.FillCircleResponse
+	49,  // 90: biotinker.component.display.v1.DisplayService.DrawEllipse:output_type -> biotinker.component.display.v1.DrawEllipseResponse
+	51,  // 91: biotinker.component.display.v1.DisplayService.FillEllipse:output_type -> biotinker.component.display.v1.FillEllipseResponse
+	53,  // 92: biotinker.component.display.v1.DisplayService.DrawRoundRect:output_type -> biotinker.component.display.v1.DrawRoundRectResponse
+	55,  // 93: biotinker.component.display.v1.DisplayService.FillRoundRect:output_type -> biotinker.component.display.v1.FillRoundRectResponse
+	57,  // 94: biotinker.component.display.v1.DisplayService.DrawTriangle:output_type -> biotinker.component.display.v1.DrawTriangleResponse
+	59,  // 95: biotinker.component.display.v1.DisplayService.FillTriangle:output_type -> biotinker.component.display.v1.FillTriangleResponse
+	61,  // 96: biotinker.component.display.v1.DisplayService.DrawBorder:output_type -> biotinker.component.display.v1.DrawBorderResponse
+	63,  // 97: biotinker.component.display.v1.DisplayService.DrawGrid:output_type -> biotinker.component.display.v1.DrawGridResponse
+	65,  // 98: biotinker.component.display.v1.DisplayService.DrawWaterfall:output_type -> biotinker.component.display.v1.DrawWaterfallResponse
+	67,  // 99: biotinker.component.display.v1.DisplayService.DrawImageRegion:output_type -> biotinker.component.display.v1.DrawImageRegionResponse
+	69,  // 100: biotinker.component.display.v1.DisplayService.DrawBitmap:output_type -> biotinker.component.display.v1.DrawBitmapResponse
+	71,  // 101: biotinker.component.display.v1.DisplayService.DrawBitmapDithered:output_type -> biotinker.component.display.v1.DrawBitmapDitheredResponse
+	73,  // 102: biotinker.component.display.v1.DisplayService.DrawBitmapRLE:output_type -> biotinker.component.display.v1.DrawBitmapRLEResponse
+	75,  // 103: biotinker.component.display.v1.DisplayService.DrawProgressBarLabeled:output_type -> biotinker.component.display.v1.DrawProgressBarLabeledResponse
+	77,  // 104: biotinker.component.display.v1.DisplayService.DrawAxes:output_type -> biotinker.component.display.v1.DrawAxesResponse
+	79,  // 105: biotinker.component.display.v1.DisplayService.DrawDPad:output_type -> biotinker.component.display.v1.DrawDPadResponse
+	81,  // 106: biotinker.component.display.v1.DisplayService.DrawKeyValues:output_type -> biotinker.component.display.v1.DrawKeyValuesResponse
+	84,  // 107: biotinker.component.display.v1.DisplayService.Batch:output_type -> biotinker.component.display.v1.BatchResponse
+	86,  // 108: biotinker.component.display.v1.DisplayService.DrawTestPattern:output_type -> biotinker.component.display.v1.DrawTestPatternResponse
+	88,  // 109: biotinker.component.display.v1.DisplayService.DrawCalibration:output_type -> biotinker.component.display.v1.DrawCalibrationResponse
+	90,  // 110: biotinker.component.display.v1.DisplayService.DisplayScaled:output_type -> biotinker.component.display.v1.DisplayScaledResponse
+	92,  // 111: biotinker.component.display.v1.DisplayService.GetDimensions:output_type -> biotinker.component.display.v1.GetDimensionsResponse
+	94,  // 112: biotinker.component.display.v1.DisplayService.GetPixel:output_type -> biotinker.component.display.v1.GetPixelResponse
+	96,  // 113: biotinker.component.display.v1.DisplayService.GetRegion:output_type -> biotinker.component.display.v1.GetRegionResponse
+	98,  // 114: biotinker.component.display.v1.DisplayService.ReadBuffer:output_type -> biotinker.component.display.v1.ReadBufferResponse
+	100, // 115: biotinker.component.display.v1.DisplayService.SetRegion:output_type -> biotinker.component.display.v1.SetRegionResponse
+	102, // 116: biotinker.component.display.v1.DisplayService.SetScale:output_type -> biotinker.component.display.v1.SetScaleResponse
+	104, // 117: biotinker.component.display.v1.DisplayService.SetFont:output_type -> biotinker.component.display.v1.SetFontResponse
+	106, // 118: biotinker.component.display.v1.DisplayService.SetContrast:output_type -> biotinker.component.display.v1.SetContrastResponse
+	108, // 119: biotinker.component.display.v1.DisplayService.SetInvert:output_type -> biotinker.component.display.v1.SetInvertResponse
+	110, // 120: biotinker.component.display.v1.DisplayService.SetDisplayOn:output_type -> biotinker.component.display.v1.SetDisplayOnResponse
+	112, // 121: biotinker.component.display.v1.DisplayService.ReadStatus:output_type -> biotinker.component.display.v1.ReadStatusResponse
+	114, // 122: biotinker.component.display.v1.DisplayService.DetectController:output_type -> biotinker.component.display.v1.DetectControllerResponse
+	116, // 123: biotinker.component.display.v1.DisplayService.Undo:output_type -> biotinker.component.display.v1.UndoResponse
+	118, // 124: biotinker.component.display.v1.DisplayService.Redo:output_type -> biotinker.component.display.v1.RedoResponse
+	120, // 125: biotinker.component.display.v1.DisplayService.Refresh:output_type -> biotinker.component.display.v1.RefreshResponse
+	122, // 126: biotinker.component.display.v1.DisplayService.Clear:output_type -> biotinker.component.display.v1.ClearResponse
+	124, // 127: biotinker.component.display.v1.DisplayService.Reset:output_type -> biotinker.component.display.v1.ResetResponse
+	126, // 128: biotinker.component.display.v1.DisplayService.DoCommand:output_type -> biotinker.component.display.v1.DoCommandResponse
+	66,  // [66:129] is the sub-list for method output_type
+	3,   // [3:66] is the sub-list for method input_type
+	3,   // [3:3] is the sub-list for extension type_name
+	3,   // [3:3] is the sub-list for extension extendee
+	0,   // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_component_display_v1_display_proto_init() }
+func file_component_display_v1_display_proto_init() {
+	if File_component_display_v1_display_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_component_display_v1_display_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisplayBytesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisplayBytesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisplayBytesRLERequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisplayBytesRLEResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisplayBytesDirtyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisplayBytesDirtyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringDoubleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringDoubleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringScaledRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringScaledResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringCursorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringCursorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringWrappedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringWrappedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringRotatedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringRotatedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringVerticalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringVerticalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringAlongArcRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringAlongArcResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringKnockoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteStringKnockoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScrollTextRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScrollTextResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartScrollRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartScrollResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopScrollRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopScrollResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeasureStringRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeasureStringResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogLineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogLineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawPixelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawPixelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearPixelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearPixelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawLineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawLineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawRectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawRectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillRectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillRectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawCircleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawCircleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillCircleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillCircleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawEllipseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawEllipseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillEllipseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillEllipseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawRoundRectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawRoundRectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillRoundRectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillRoundRectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawTriangleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawTriangleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillTriangleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillTriangleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawBorderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawBorderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawGridRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawGridResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawWaterfallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawWaterfallResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawImageRegionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawImageRegionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawBitmapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawBitmapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawBitmapDitheredRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawBitmapDitheredResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawBitmapRLERequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawBitmapRLEResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawProgressBarLabeledRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawProgressBarLabeledResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawAxesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawAxesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawDPadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawDPadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawKeyValuesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawKeyValuesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawTestPatternRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawTestPatternResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawCalibrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawCalibrationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisplayScaledRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisplayScaledResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDimensionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDimensionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPixelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -702,8 +9767,8 @@ func file_component_display_v1_display_proto_init() {
 				return nil
 			}
 		}
-		file_component_display_v1_display_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisplayBytesResponse); i {
+		file_component_display_v1_display_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPixelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -714,8 +9779,8 @@ func file_component_display_v1_display_proto_init() {
 				return nil
 			}
 		}
-		file_component_display_v1_display_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteStringRequest); i {
+		file_component_display_v1_display_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -726,8 +9791,8 @@ func file_component_display_v1_display_proto_init() {
 				return nil
 			}
 		}
-		file_component_display_v1_display_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteStringResponse); i {
+		file_component_display_v1_display_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -738,8 +9803,8 @@ func file_component_display_v1_display_proto_init() {
 				return nil
 			}
 		}
-		file_component_display_v1_display_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DrawLineRequest); i {
+		file_component_display_v1_display_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadBufferRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -750,8 +9815,8 @@ func file_component_display_v1_display_proto_init() {
 				return nil
 			}
 		}
-		file_component_display_v1_display_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DrawLineResponse); i {
+		file_component_display_v1_display_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadBufferResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -762,7 +9827,295 @@ func file_component_display_v1_display_proto_init() {
 				return nil
 			}
 		}
-		file_component_display_v1_display_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_component_display_v1_display_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRegionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRegionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetScaleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetScaleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFontRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFontResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetContrastRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetContrastResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetInvertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetInvertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDisplayOnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDisplayOnResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectControllerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectControllerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_display_v1_display_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResetRequest); i {
 			case 0:
 				return &v.state
@@ -774,7 +10127,7 @@ func file_component_display_v1_display_proto_init() {
 				return nil
 			}
 		}
-		file_component_display_v1_display_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_component_display_v1_display_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResetResponse); i {
 			case 0:
 				return &v.state
@@ -786,7 +10139,7 @@ func file_component_display_v1_display_proto_init() {
 				return nil
 			}
 		}
-		file_component_display_v1_display_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_component_display_v1_display_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DoCommandRequest); i {
 			case 0:
 				return &v.state
@@ -798,7 +10151,7 @@ func file_component_display_v1_display_proto_init() {
 				return nil
 			}
 		}
-		file_component_display_v1_display_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_component_display_v1_display_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DoCommandResponse); i {
 			case 0:
 				return &v.state
@@ -811,13 +10164,14 @@ func file_component_display_v1_display_proto_init() {
 			}
 		}
 	}
+	file_component_display_v1_display_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_component_display_v1_display_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   127,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,69 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	DisplayService_DisplayBytes_FullMethodName = "/biotinker.component.display.v1.DisplayService/DisplayBytes"
-	DisplayService_WriteString_FullMethodName  = "/biotinker.component.display.v1.DisplayService/WriteString"
-	DisplayService_DrawLine_FullMethodName     = "/biotinker.component.display.v1.DisplayService/DrawLine"
-	DisplayService_Reset_FullMethodName        = "/biotinker.component.display.v1.DisplayService/Reset"
-	DisplayService_DoCommand_FullMethodName    = "/biotinker.component.display.v1.DisplayService/DoCommand"
+	DisplayService_DisplayBytes_FullMethodName           = "/biotinker.component.display.v1.DisplayService/DisplayBytes"
+	DisplayService_DisplayBytesRLE_FullMethodName        = "/biotinker.component.display.v1.DisplayService/DisplayBytesRLE"
+	DisplayService_DisplayBytesDirty_FullMethodName      = "/biotinker.component.display.v1.DisplayService/DisplayBytesDirty"
+	DisplayService_WriteString_FullMethodName            = "/biotinker.component.display.v1.DisplayService/WriteString"
+	DisplayService_WriteStringDouble_FullMethodName      = "/biotinker.component.display.v1.DisplayService/WriteStringDouble"
+	DisplayService_WriteStringScaled_FullMethodName      = "/biotinker.component.display.v1.DisplayService/WriteStringScaled"
+	DisplayService_WriteStringCursor_FullMethodName      = "/biotinker.component.display.v1.DisplayService/WriteStringCursor"
+	DisplayService_WriteStringWrapped_FullMethodName     = "/biotinker.component.display.v1.DisplayService/WriteStringWrapped"
+	DisplayService_WriteStringRotated_FullMethodName     = "/biotinker.component.display.v1.DisplayService/WriteStringRotated"
+	DisplayService_WriteStringVertical_FullMethodName    = "/biotinker.component.display.v1.DisplayService/WriteStringVertical"
+	DisplayService_WriteStringAlongArc_FullMethodName    = "/biotinker.component.display.v1.DisplayService/WriteStringAlongArc"
+	DisplayService_WriteStringKnockout_FullMethodName    = "/biotinker.component.display.v1.DisplayService/WriteStringKnockout"
+	DisplayService_ScrollText_FullMethodName             = "/biotinker.component.display.v1.DisplayService/ScrollText"
+	DisplayService_StartScroll_FullMethodName            = "/biotinker.component.display.v1.DisplayService/StartScroll"
+	DisplayService_StopScroll_FullMethodName             = "/biotinker.component.display.v1.DisplayService/StopScroll"
+	DisplayService_MeasureString_FullMethodName          = "/biotinker.component.display.v1.DisplayService/MeasureString"
+	DisplayService_LogLine_FullMethodName                = "/biotinker.component.display.v1.DisplayService/LogLine"
+	DisplayService_DrawPixel_FullMethodName              = "/biotinker.component.display.v1.DisplayService/DrawPixel"
+	DisplayService_ClearPixel_FullMethodName             = "/biotinker.component.display.v1.DisplayService/ClearPixel"
+	DisplayService_DrawLine_FullMethodName               = "/biotinker.component.display.v1.DisplayService/DrawLine"
+	DisplayService_DrawRect_FullMethodName               = "/biotinker.component.display.v1.DisplayService/DrawRect"
+	DisplayService_FillRect_FullMethodName               = "/biotinker.component.display.v1.DisplayService/FillRect"
+	DisplayService_DrawCircle_FullMethodName             = "/biotinker.component.display.v1.DisplayService/DrawCircle"
+	DisplayService_FillCircle_FullMethodName             = "/biotinker.component.display.v1.DisplayService/FillCircle"
+	DisplayService_DrawEllipse_FullMethodName            = "/biotinker.component.display.v1.DisplayService/DrawEllipse"
+	DisplayService_FillEllipse_FullMethodName            = "/biotinker.component.display.v1.DisplayService/FillEllipse"
+	DisplayService_DrawRoundRect_FullMethodName          = "/biotinker.component.display.v1.DisplayService/DrawRoundRect"
+	DisplayService_FillRoundRect_FullMethodName          = "/biotinker.component.display.v1.DisplayService/FillRoundRect"
+	DisplayService_DrawTriangle_FullMethodName           = "/biotinker.component.display.v1.DisplayService/DrawTriangle"
+	DisplayService_FillTriangle_FullMethodName           = "/biotinker.component.display.v1.DisplayService/FillTriangle"
+	DisplayService_DrawBorder_FullMethodName             = "/biotinker.component.display.v1.DisplayService/DrawBorder"
+	DisplayService_DrawGrid_FullMethodName               = "/biotinker.component.display.v1.DisplayService/DrawGrid"
+	DisplayService_DrawWaterfall_FullMethodName          = "/biotinker.component.display.v1.DisplayService/DrawWaterfall"
+	DisplayService_DrawImageRegion_FullMethodName        = "/biotinker.component.display.v1.DisplayService/DrawImageRegion"
+	DisplayService_DrawBitmap_FullMethodName             = "/biotinker.component.display.v1.DisplayService/DrawBitmap"
+	DisplayService_DrawBitmapDithered_FullMethodName     = "/biotinker.component.display.v1.DisplayService/DrawBitmapDithered"
+	DisplayService_DrawBitmapRLE_FullMethodName          = "/biotinker.component.display.v1.DisplayService/DrawBitmapRLE"
+	DisplayService_DrawProgressBarLabeled_FullMethodName = "/biotinker.component.display.v1.DisplayService/DrawProgressBarLabeled"
+	DisplayService_DrawAxes_FullMethodName               = "/biotinker.component.display.v1.DisplayService/DrawAxes"
+	DisplayService_DrawDPad_FullMethodName               = "/biotinker.component.display.v1.DisplayService/DrawDPad"
+	DisplayService_DrawKeyValues_FullMethodName          = "/biotinker.component.display.v1.DisplayService/DrawKeyValues"
+	DisplayService_Batch_FullMethodName                  = "/biotinker.component.display.v1.DisplayService/Batch"
+	DisplayService_DrawTestPattern_FullMethodName        = "/biotinker.component.display.v1.DisplayService/DrawTestPattern"
+	DisplayService_DrawCalibration_FullMethodName        = "/biotinker.component.display.v1.DisplayService/DrawCalibration"
+	DisplayService_DisplayScaled_FullMethodName          = "/biotinker.component.display.v1.DisplayService/DisplayScaled"
+	DisplayService_GetDimensions_FullMethodName          = "/biotinker.component.display.v1.DisplayService/GetDimensions"
+	DisplayService_GetPixel_FullMethodName               = "/biotinker.component.display.v1.DisplayService/GetPixel"
+	DisplayService_GetRegion_FullMethodName              = "/biotinker.component.display.v1.DisplayService/GetRegion"
+	DisplayService_ReadBuffer_FullMethodName             = "/biotinker.component.display.v1.DisplayService/ReadBuffer"
+	DisplayService_SetRegion_FullMethodName              = "/biotinker.component.display.v1.DisplayService/SetRegion"
+	DisplayService_SetScale_FullMethodName               = "/biotinker.component.display.v1.DisplayService/SetScale"
+	DisplayService_SetFont_FullMethodName                = "/biotinker.component.display.v1.DisplayService/SetFont"
+	DisplayService_SetContrast_FullMethodName            = "/biotinker.component.display.v1.DisplayService/SetContrast"
+	DisplayService_SetInvert_FullMethodName              = "/biotinker.component.display.v1.DisplayService/SetInvert"
+	DisplayService_SetDisplayOn_FullMethodName           = "/biotinker.component.display.v1.DisplayService/SetDisplayOn"
+	DisplayService_ReadStatus_FullMethodName             = "/biotinker.component.display.v1.DisplayService/ReadStatus"
+	DisplayService_DetectController_FullMethodName       = "/biotinker.component.display.v1.DisplayService/DetectController"
+	DisplayService_Undo_FullMethodName                   = "/biotinker.component.display.v1.DisplayService/Undo"
+	DisplayService_Redo_FullMethodName                   = "/biotinker.component.display.v1.DisplayService/Redo"
+	DisplayService_Refresh_FullMethodName                = "/biotinker.component.display.v1.DisplayService/Refresh"
+	DisplayService_Clear_FullMethodName                  = "/biotinker.component.display.v1.DisplayService/Clear"
+	DisplayService_Reset_FullMethodName                  = "/biotinker.component.display.v1.DisplayService/Reset"
+	DisplayService_DoCommand_FullMethodName              = "/biotinker.component.display.v1.DisplayService/DoCommand"
 )
 
 // DisplayServiceClient is the client API for DisplayService service.
@@ -31,8 +89,66 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DisplayServiceClient interface {
 	DisplayBytes(ctx context.Context, in *DisplayBytesRequest, opts ...grpc.CallOption) (*DisplayBytesResponse, error)
+	DisplayBytesRLE(ctx context.Context, in *DisplayBytesRLERequest, opts ...grpc.CallOption) (*DisplayBytesRLEResponse, error)
+	DisplayBytesDirty(ctx context.Context, in *DisplayBytesDirtyRequest, opts ...grpc.CallOption) (*DisplayBytesDirtyResponse, error)
 	WriteString(ctx context.Context, in *WriteStringRequest, opts ...grpc.CallOption) (*WriteStringResponse, error)
+	WriteStringDouble(ctx context.Context, in *WriteStringDoubleRequest, opts ...grpc.CallOption) (*WriteStringDoubleResponse, error)
+	WriteStringScaled(ctx context.Context, in *WriteStringScaledRequest, opts ...grpc.CallOption) (*WriteStringScaledResponse, error)
+	WriteStringCursor(ctx context.Context, in *WriteStringCursorRequest, opts ...grpc.CallOption) (*WriteStringCursorResponse, error)
+	WriteStringWrapped(ctx context.Context, in *WriteStringWrappedRequest, opts ...grpc.CallOption) (*WriteStringWrappedResponse, error)
+	WriteStringRotated(ctx context.Context, in *WriteStringRotatedRequest, opts ...grpc.CallOption) (*WriteStringRotatedResponse, error)
+	WriteStringVertical(ctx context.Context, in *WriteStringVerticalRequest, opts ...grpc.CallOption) (*WriteStringVerticalResponse, error)
+	WriteStringAlongArc(ctx context.Context, in *WriteStringAlongArcRequest, opts ...grpc.CallOption) (*WriteStringAlongArcResponse, error)
+	WriteStringKnockout(ctx context.Context, in *WriteStringKnockoutRequest, opts ...grpc.CallOption) (*WriteStringKnockoutResponse, error)
+	ScrollText(ctx context.Context, in *ScrollTextRequest, opts ...grpc.CallOption) (*ScrollTextResponse, error)
+	StartScroll(ctx context.Context, in *StartScrollRequest, opts ...grpc.CallOption) (*StartScrollResponse, error)
+	StopScroll(ctx context.Context, in *StopScrollRequest, opts ...grpc.CallOption) (*StopScrollResponse, error)
+	MeasureString(ctx context.Context, in *MeasureStringRequest, opts ...grpc.CallOption) (*MeasureStringResponse, error)
+	LogLine(ctx context.Context, in *LogLineRequest, opts ...grpc.CallOption) (*LogLineResponse, error)
+	DrawPixel(ctx context.Context, in *DrawPixelRequest, opts ...grpc.CallOption) (*DrawPixelResponse, error)
+	ClearPixel(ctx context.Context, in *ClearPixelRequest, opts ...grpc.CallOption) (*ClearPixelResponse, error)
 	DrawLine(ctx context.Context, in *DrawLineRequest, opts ...grpc.CallOption) (*DrawLineResponse, error)
+	DrawRect(ctx context.Context, in *DrawRectRequest, opts ...grpc.CallOption) (*DrawRectResponse, error)
+	FillRect(ctx context.Context, in *FillRectRequest, opts ...grpc.CallOption) (*FillRectResponse, error)
+	DrawCircle(ctx context.Context, in *DrawCircleRequest, opts ...grpc.CallOption) (*DrawCircleResponse, error)
+	FillCircle(ctx context.Context, in *FillCircleRequest, opts ...grpc.CallOption) (*FillCircleResponse, error)
+	DrawEllipse(ctx context.Context, in *DrawEllipseRequest, opts ...grpc.CallOption) (*DrawEllipseResponse, error)
+	FillEllipse(ctx context.Context, in *FillEllipseRequest, opts ...grpc.CallOption) (*FillEllipseResponse, error)
+	DrawRoundRect(ctx context.Context, in *DrawRoundRectRequest, opts ...grpc.CallOption) (*DrawRoundRectResponse, error)
+	FillRoundRect(ctx context.Context, in *FillRoundRectRequest, opts ...grpc.CallOption) (*FillRoundRectResponse, error)
+	DrawTriangle(ctx context.Context, in *DrawTriangleRequest, opts ...grpc.CallOption) (*DrawTriangleResponse, error)
+	FillTriangle(ctx context.Context, in *FillTriangleRequest, opts ...grpc.CallOption) (*FillTriangleResponse, error)
+	DrawBorder(ctx context.Context, in *DrawBorderRequest, opts ...grpc.CallOption) (*DrawBorderResponse, error)
+	DrawGrid(ctx context.Context, in *DrawGridRequest, opts ...grpc.CallOption) (*DrawGridResponse, error)
+	DrawWaterfall(ctx context.Context, in *DrawWaterfallRequest, opts ...grpc.CallOption) (*DrawWaterfallResponse, error)
+	DrawImageRegion(ctx context.Context, in *DrawImageRegionRequest, opts ...grpc.CallOption) (*DrawImageRegionResponse, error)
+	DrawBitmap(ctx context.Context, in *DrawBitmapRequest, opts ...grpc.CallOption) (*DrawBitmapResponse, error)
+	DrawBitmapDithered(ctx context.Context, in *DrawBitmapDitheredRequest, opts ...grpc.CallOption) (*DrawBitmapDitheredResponse, error)
+	DrawBitmapRLE(ctx context.Context, in *DrawBitmapRLERequest, opts ...grpc.CallOption) (*DrawBitmapRLEResponse, error)
+	DrawProgressBarLabeled(ctx context.Context, in *DrawProgressBarLabeledRequest, opts ...grpc.CallOption) (*DrawProgressBarLabeledResponse, error)
+	DrawAxes(ctx context.Context, in *DrawAxesRequest, opts ...grpc.CallOption) (*DrawAxesResponse, error)
+	DrawDPad(ctx context.Context, in *DrawDPadRequest, opts ...grpc.CallOption) (*DrawDPadResponse, error)
+	DrawKeyValues(ctx context.Context, in *DrawKeyValuesRequest, opts ...grpc.CallOption) (*DrawKeyValuesResponse, error)
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	DrawTestPattern(ctx context.Context, in *DrawTestPatternRequest, opts ...grpc.CallOption) (*DrawTestPatternResponse, error)
+	DrawCalibration(ctx context.Context, in *DrawCalibrationRequest, opts ...grpc.CallOption) (*DrawCalibrationResponse, error)
+	DisplayScaled(ctx context.Context, in *DisplayScaledRequest, opts ...grpc.CallOption) (*DisplayScaledResponse, error)
+	GetDimensions(ctx context.Context, in *GetDimensionsRequest, opts ...grpc.CallOption) (*GetDimensionsResponse, error)
+	GetPixel(ctx context.Context, in *GetPixelRequest, opts ...grpc.CallOption) (*GetPixelResponse, error)
+	GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
+	ReadBuffer(ctx context.Context, in *ReadBufferRequest, opts ...grpc.CallOption) (*ReadBufferResponse, error)
+	SetRegion(ctx context.Context, in *SetRegionRequest, opts ...grpc.CallOption) (*SetRegionResponse, error)
+	SetScale(ctx context.Context, in *SetScaleRequest, opts ...grpc.CallOption) (*SetScaleResponse, error)
+	SetFont(ctx context.Context, in *SetFontRequest, opts ...grpc.CallOption) (*SetFontResponse, error)
+	SetContrast(ctx context.Context, in *SetContrastRequest, opts ...grpc.CallOption) (*SetContrastResponse, error)
+	SetInvert(ctx context.Context, in *SetInvertRequest, opts ...grpc.CallOption) (*SetInvertResponse, error)
+	SetDisplayOn(ctx context.Context, in *SetDisplayOnRequest, opts ...grpc.CallOption) (*SetDisplayOnResponse, error)
+	ReadStatus(ctx context.Context, in *ReadStatusRequest, opts ...grpc.CallOption) (*ReadStatusResponse, error)
+	DetectController(ctx context.Context, in *DetectControllerRequest, opts ...grpc.CallOption) (*DetectControllerResponse, error)
+	Undo(ctx context.Context, in *UndoRequest, opts ...grpc.CallOption) (*UndoResponse, error)
+	Redo(ctx context.Context, in *RedoRequest, opts ...grpc.CallOption) (*RedoResponse, error)
+	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error)
+	Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error)
 	Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetResponse, error)
 	DoCommand(ctx context.Context, in *DoCommandRequest, opts ...grpc.CallOption) (*DoCommandResponse, error)
 }
@@ -54,136 +170,1934 @@ func (c *displayServiceClient) DisplayBytes(ctx context.Context, in *DisplayByte
 	return out, nil
 }
 
+func (c *displayServiceClient) DisplayBytesRLE(ctx context.Context, in *DisplayBytesRLERequest, opts ...grpc.CallOption) (*DisplayBytesRLEResponse, error) {
+	out := new(DisplayBytesRLEResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DisplayBytesRLE_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DisplayBytesDirty(ctx context.Context, in *DisplayBytesDirtyRequest, opts ...grpc.CallOption) (*DisplayBytesDirtyResponse, error) {
+	out := new(DisplayBytesDirtyResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DisplayBytesDirty_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *displayServiceClient) WriteString(ctx context.Context, in *WriteStringRequest, opts ...grpc.CallOption) (*WriteStringResponse, error) {
 	out := new(WriteStringResponse)
 	err := c.cc.Invoke(ctx, DisplayService_WriteString_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *displayServiceClient) WriteStringDouble(ctx context.Context, in *WriteStringDoubleRequest, opts ...grpc.CallOption) (*WriteStringDoubleResponse, error) {
+	out := new(WriteStringDoubleResponse)
+	err := c.cc.Invoke(ctx, DisplayService_WriteStringDouble_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) WriteStringScaled(ctx context.Context, in *WriteStringScaledRequest, opts ...grpc.CallOption) (*WriteStringScaledResponse, error) {
+	out := new(WriteStringScaledResponse)
+	err := c.cc.Invoke(ctx, DisplayService_WriteStringScaled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) WriteStringCursor(ctx context.Context, in *WriteStringCursorRequest, opts ...grpc.CallOption) (*WriteStringCursorResponse, error) {
+	out := new(WriteStringCursorResponse)
+	err := c.cc.Invoke(ctx, DisplayService_WriteStringCursor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) WriteStringWrapped(ctx context.Context, in *WriteStringWrappedRequest, opts ...grpc.CallOption) (*WriteStringWrappedResponse, error) {
+	out := new(WriteStringWrappedResponse)
+	err := c.cc.Invoke(ctx, DisplayService_WriteStringWrapped_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) WriteStringRotated(ctx context.Context, in *WriteStringRotatedRequest, opts ...grpc.CallOption) (*WriteStringRotatedResponse, error) {
+	out := new(WriteStringRotatedResponse)
+	err := c.cc.Invoke(ctx, DisplayService_WriteStringRotated_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) WriteStringVertical(ctx context.Context, in *WriteStringVerticalRequest, opts ...grpc.CallOption) (*WriteStringVerticalResponse, error) {
+	out := new(WriteStringVerticalResponse)
+	err := c.cc.Invoke(ctx, DisplayService_WriteStringVertical_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) WriteStringAlongArc(ctx context.Context, in *WriteStringAlongArcRequest, opts ...grpc.CallOption) (*WriteStringAlongArcResponse, error) {
+	out := new(WriteStringAlongArcResponse)
+	err := c.cc.Invoke(ctx, DisplayService_WriteStringAlongArc_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) WriteStringKnockout(ctx context.Context, in *WriteStringKnockoutRequest, opts ...grpc.CallOption) (*WriteStringKnockoutResponse, error) {
+	out := new(WriteStringKnockoutResponse)
+	err := c.cc.Invoke(ctx, DisplayService_WriteStringKnockout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) ScrollText(ctx context.Context, in *ScrollTextRequest, opts ...grpc.CallOption) (*ScrollTextResponse, error) {
+	out := new(ScrollTextResponse)
+	err := c.cc.Invoke(ctx, DisplayService_ScrollText_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) StartScroll(ctx context.Context, in *StartScrollRequest, opts ...grpc.CallOption) (*StartScrollResponse, error) {
+	out := new(StartScrollResponse)
+	err := c.cc.Invoke(ctx, DisplayService_StartScroll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) StopScroll(ctx context.Context, in *StopScrollRequest, opts ...grpc.CallOption) (*StopScrollResponse, error) {
+	out := new(StopScrollResponse)
+	err := c.cc.Invoke(ctx, DisplayService_StopScroll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) MeasureString(ctx context.Context, in *MeasureStringRequest, opts ...grpc.CallOption) (*MeasureStringResponse, error) {
+	out := new(MeasureStringResponse)
+	err := c.cc.Invoke(ctx, DisplayService_MeasureString_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) LogLine(ctx context.Context, in *LogLineRequest, opts ...grpc.CallOption) (*LogLineResponse, error) {
+	out := new(LogLineResponse)
+	err := c.cc.Invoke(ctx, DisplayService_LogLine_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawPixel(ctx context.Context, in *DrawPixelRequest, opts ...grpc.CallOption) (*DrawPixelResponse, error) {
+	out := new(DrawPixelResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawPixel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) ClearPixel(ctx context.Context, in *ClearPixelRequest, opts ...grpc.CallOption) (*ClearPixelResponse, error) {
+	out := new(ClearPixelResponse)
+	err := c.cc.Invoke(ctx, DisplayService_ClearPixel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawLine(ctx context.Context, in *DrawLineRequest, opts ...grpc.CallOption) (*DrawLineResponse, error) {
+	out := new(DrawLineResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawLine_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawRect(ctx context.Context, in *DrawRectRequest, opts ...grpc.CallOption) (*DrawRectResponse, error) {
+	out := new(DrawRectResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawRect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) FillRect(ctx context.Context, in *FillRectRequest, opts ...grpc.CallOption) (*FillRectResponse, error) {
+	out := new(FillRectResponse)
+	err := c.cc.Invoke(ctx, DisplayService_FillRect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawCircle(ctx context.Context, in *DrawCircleRequest, opts ...grpc.CallOption) (*DrawCircleResponse, error) {
+	out := new(DrawCircleResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawCircle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) FillCircle(ctx context.Context, in *FillCircleRequest, opts ...grpc.CallOption) (*FillCircleResponse, error) {
+	out := new(FillCircleResponse)
+	err := c.cc.Invoke(ctx, DisplayService_FillCircle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawEllipse(ctx context.Context, in *DrawEllipseRequest, opts ...grpc.CallOption) (*DrawEllipseResponse, error) {
+	out := new(DrawEllipseResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawEllipse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) FillEllipse(ctx context.Context, in *FillEllipseRequest, opts ...grpc.CallOption) (*FillEllipseResponse, error) {
+	out := new(FillEllipseResponse)
+	err := c.cc.Invoke(ctx, DisplayService_FillEllipse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawRoundRect(ctx context.Context, in *DrawRoundRectRequest, opts ...grpc.CallOption) (*DrawRoundRectResponse, error) {
+	out := new(DrawRoundRectResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawRoundRect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) FillRoundRect(ctx context.Context, in *FillRoundRectRequest, opts ...grpc.CallOption) (*FillRoundRectResponse, error) {
+	out := new(FillRoundRectResponse)
+	err := c.cc.Invoke(ctx, DisplayService_FillRoundRect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawTriangle(ctx context.Context, in *DrawTriangleRequest, opts ...grpc.CallOption) (*DrawTriangleResponse, error) {
+	out := new(DrawTriangleResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawTriangle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) FillTriangle(ctx context.Context, in *FillTriangleRequest, opts ...grpc.CallOption) (*FillTriangleResponse, error) {
+	out := new(FillTriangleResponse)
+	err := c.cc.Invoke(ctx, DisplayService_FillTriangle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawBorder(ctx context.Context, in *DrawBorderRequest, opts ...grpc.CallOption) (*DrawBorderResponse, error) {
+	out := new(DrawBorderResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawBorder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawGrid(ctx context.Context, in *DrawGridRequest, opts ...grpc.CallOption) (*DrawGridResponse, error) {
+	out := new(DrawGridResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawGrid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawWaterfall(ctx context.Context, in *DrawWaterfallRequest, opts ...grpc.CallOption) (*DrawWaterfallResponse, error) {
+	out := new(DrawWaterfallResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawWaterfall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawImageRegion(ctx context.Context, in *DrawImageRegionRequest, opts ...grpc.CallOption) (*DrawImageRegionResponse, error) {
+	out := new(DrawImageRegionResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawImageRegion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawBitmap(ctx context.Context, in *DrawBitmapRequest, opts ...grpc.CallOption) (*DrawBitmapResponse, error) {
+	out := new(DrawBitmapResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawBitmap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawBitmapDithered(ctx context.Context, in *DrawBitmapDitheredRequest, opts ...grpc.CallOption) (*DrawBitmapDitheredResponse, error) {
+	out := new(DrawBitmapDitheredResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawBitmapDithered_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawBitmapRLE(ctx context.Context, in *DrawBitmapRLERequest, opts ...grpc.CallOption) (*DrawBitmapRLEResponse, error) {
+	out := new(DrawBitmapRLEResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawBitmapRLE_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawProgressBarLabeled(ctx context.Context, in *DrawProgressBarLabeledRequest, opts ...grpc.CallOption) (*DrawProgressBarLabeledResponse, error) {
+	out := new(DrawProgressBarLabeledResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawProgressBarLabeled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawAxes(ctx context.Context, in *DrawAxesRequest, opts ...grpc.CallOption) (*DrawAxesResponse, error) {
+	out := new(DrawAxesResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawAxes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawDPad(ctx context.Context, in *DrawDPadRequest, opts ...grpc.CallOption) (*DrawDPadResponse, error) {
+	out := new(DrawDPadResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawDPad_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawKeyValues(ctx context.Context, in *DrawKeyValuesRequest, opts ...grpc.CallOption) (*DrawKeyValuesResponse, error) {
+	out := new(DrawKeyValuesResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawKeyValues_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, DisplayService_Batch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawTestPattern(ctx context.Context, in *DrawTestPatternRequest, opts ...grpc.CallOption) (*DrawTestPatternResponse, error) {
+	out := new(DrawTestPatternResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawTestPattern_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DrawCalibration(ctx context.Context, in *DrawCalibrationRequest, opts ...grpc.CallOption) (*DrawCalibrationResponse, error) {
+	out := new(DrawCalibrationResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DrawCalibration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DisplayScaled(ctx context.Context, in *DisplayScaledRequest, opts ...grpc.CallOption) (*DisplayScaledResponse, error) {
+	out := new(DisplayScaledResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DisplayScaled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) GetDimensions(ctx context.Context, in *GetDimensionsRequest, opts ...grpc.CallOption) (*GetDimensionsResponse, error) {
+	out := new(GetDimensionsResponse)
+	err := c.cc.Invoke(ctx, DisplayService_GetDimensions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) GetPixel(ctx context.Context, in *GetPixelRequest, opts ...grpc.CallOption) (*GetPixelResponse, error) {
+	out := new(GetPixelResponse)
+	err := c.cc.Invoke(ctx, DisplayService_GetPixel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
+	out := new(GetRegionResponse)
+	err := c.cc.Invoke(ctx, DisplayService_GetRegion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) ReadBuffer(ctx context.Context, in *ReadBufferRequest, opts ...grpc.CallOption) (*ReadBufferResponse, error) {
+	out := new(ReadBufferResponse)
+	err := c.cc.Invoke(ctx, DisplayService_ReadBuffer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) SetRegion(ctx context.Context, in *SetRegionRequest, opts ...grpc.CallOption) (*SetRegionResponse, error) {
+	out := new(SetRegionResponse)
+	err := c.cc.Invoke(ctx, DisplayService_SetRegion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) SetScale(ctx context.Context, in *SetScaleRequest, opts ...grpc.CallOption) (*SetScaleResponse, error) {
+	out := new(SetScaleResponse)
+	err := c.cc.Invoke(ctx, DisplayService_SetScale_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) SetFont(ctx context.Context, in *SetFontRequest, opts ...grpc.CallOption) (*SetFontResponse, error) {
+	out := new(SetFontResponse)
+	err := c.cc.Invoke(ctx, DisplayService_SetFont_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) SetContrast(ctx context.Context, in *SetContrastRequest, opts ...grpc.CallOption) (*SetContrastResponse, error) {
+	out := new(SetContrastResponse)
+	err := c.cc.Invoke(ctx, DisplayService_SetContrast_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) SetInvert(ctx context.Context, in *SetInvertRequest, opts ...grpc.CallOption) (*SetInvertResponse, error) {
+	out := new(SetInvertResponse)
+	err := c.cc.Invoke(ctx, DisplayService_SetInvert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) SetDisplayOn(ctx context.Context, in *SetDisplayOnRequest, opts ...grpc.CallOption) (*SetDisplayOnResponse, error) {
+	out := new(SetDisplayOnResponse)
+	err := c.cc.Invoke(ctx, DisplayService_SetDisplayOn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) ReadStatus(ctx context.Context, in *ReadStatusRequest, opts ...grpc.CallOption) (*ReadStatusResponse, error) {
+	out := new(ReadStatusResponse)
+	err := c.cc.Invoke(ctx, DisplayService_ReadStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DetectController(ctx context.Context, in *DetectControllerRequest, opts ...grpc.CallOption) (*DetectControllerResponse, error) {
+	out := new(DetectControllerResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DetectController_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) Undo(ctx context.Context, in *UndoRequest, opts ...grpc.CallOption) (*UndoResponse, error) {
+	out := new(UndoResponse)
+	err := c.cc.Invoke(ctx, DisplayService_Undo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) Redo(ctx context.Context, in *RedoRequest, opts ...grpc.CallOption) (*RedoResponse, error) {
+	out := new(RedoResponse)
+	err := c.cc.Invoke(ctx, DisplayService_Redo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error) {
+	out := new(RefreshResponse)
+	err := c.cc.Invoke(ctx, DisplayService_Refresh_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error) {
+	out := new(ClearResponse)
+	err := c.cc.Invoke(ctx, DisplayService_Clear_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetResponse, error) {
+	out := new(ResetResponse)
+	err := c.cc.Invoke(ctx, DisplayService_Reset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *displayServiceClient) DoCommand(ctx context.Context, in *DoCommandRequest, opts ...grpc.CallOption) (*DoCommandResponse, error) {
+	out := new(DoCommandResponse)
+	err := c.cc.Invoke(ctx, DisplayService_DoCommand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DisplayServiceServer is the server API for DisplayService service.
+// All implementations must embed UnimplementedDisplayServiceServer
+// for forward compatibility
+type DisplayServiceServer interface {
+	DisplayBytes(context.Context, *DisplayBytesRequest) (*DisplayBytesResponse, error)
+	DisplayBytesRLE(context.Context, *DisplayBytesRLERequest) (*DisplayBytesRLEResponse, error)
+	DisplayBytesDirty(context.Context, *DisplayBytesDirtyRequest) (*DisplayBytesDirtyResponse, error)
+	WriteString(context.Context, *WriteStringRequest) (*WriteStringResponse, error)
+	WriteStringDouble(context.Context, *WriteStringDoubleRequest) (*WriteStringDoubleResponse, error)
+	WriteStringScaled(context.Context, *WriteStringScaledRequest) (*WriteStringScaledResponse, error)
+	WriteStringCursor(context.Context, *WriteStringCursorRequest) (*WriteStringCursorResponse, error)
+	WriteStringWrapped(context.Context, *WriteStringWrappedRequest) (*WriteStringWrappedResponse, error)
+	WriteStringRotated(context.Context, *WriteStringRotatedRequest) (*WriteStringRotatedResponse, error)
+	WriteStringVertical(context.Context, *WriteStringVerticalRequest) (*WriteStringVerticalResponse, error)
+	WriteStringAlongArc(context.Context, *WriteStringAlongArcRequest) (*WriteStringAlongArcResponse, error)
+	WriteStringKnockout(context.Context, *WriteStringKnockoutRequest) (*WriteStringKnockoutResponse, error)
+	ScrollText(context.Context, *ScrollTextRequest) (*ScrollTextResponse, error)
+	StartScroll(context.Context, *StartScrollRequest) (*StartScrollResponse, error)
+	StopScroll(context.Context, *StopScrollRequest) (*StopScrollResponse, error)
+	MeasureString(context.Context, *MeasureStringRequest) (*MeasureStringResponse, error)
+	LogLine(context.Context, *LogLineRequest) (*LogLineResponse, error)
+	DrawPixel(context.Context, *DrawPixelRequest) (*DrawPixelResponse, error)
+	ClearPixel(context.Context, *ClearPixelRequest) (*ClearPixelResponse, error)
+	DrawLine(context.Context, *DrawLineRequest) (*DrawLineResponse, error)
+	DrawRect(context.Context, *DrawRectRequest) (*DrawRectResponse, error)
+	FillRect(context.Context, *FillRectRequest) (*FillRectResponse, error)
+	DrawCircle(context.Context, *DrawCircleRequest) (*DrawCircleResponse, error)
+	FillCircle(context.Context, *FillCircleRequest) (*FillCircleResponse, error)
+	DrawEllipse(context.Context, *DrawEllipseRequest) (*DrawEllipseResponse, error)
+	FillEllipse(context.Context, *FillEllipseRequest) (*FillEllipseResponse, error)
+	DrawRoundRect(context.Context, *DrawRoundRectRequest) (*DrawRoundRectResponse, error)
+	FillRoundRect(context.Context, *FillRoundRectRequest) (*FillRoundRectResponse, error)
+	DrawTriangle(context.Context, *DrawTriangleRequest) (*DrawTriangleResponse, error)
+	FillTriangle(context.Context, *FillTriangleRequest) (*FillTriangleResponse, error)
+	DrawBorder(context.Context, *DrawBorderRequest) (*DrawBorderResponse, error)
+	DrawGrid(context.Context, *DrawGridRequest) (*DrawGridResponse, error)
+	DrawWaterfall(context.Context, *DrawWaterfallRequest) (*DrawWaterfallResponse, error)
+	DrawImageRegion(context.Context, *DrawImageRegionRequest) (*DrawImageRegionResponse, error)
+	DrawBitmap(context.Context, *DrawBitmapRequest) (*DrawBitmapResponse, error)
+	DrawBitmapDithered(context.Context, *DrawBitmapDitheredRequest) (*DrawBitmapDitheredResponse, error)
+	DrawBitmapRLE(context.Context, *DrawBitmapRLERequest) (*DrawBitmapRLEResponse, error)
+	DrawProgressBarLabeled(context.Context, *DrawProgressBarLabeledRequest) (*DrawProgressBarLabeledResponse, error)
+	DrawAxes(context.Context, *DrawAxesRequest) (*DrawAxesResponse, error)
+	DrawDPad(context.Context, *DrawDPadRequest) (*DrawDPadResponse, error)
+	DrawKeyValues(context.Context, *DrawKeyValuesRequest) (*DrawKeyValuesResponse, error)
+	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
+	DrawTestPattern(context.Context, *DrawTestPatternRequest) (*DrawTestPatternResponse, error)
+	DrawCalibration(context.Context, *DrawCalibrationRequest) (*DrawCalibrationResponse, error)
+	DisplayScaled(context.Context, *DisplayScaledRequest) (*DisplayScaledResponse, error)
+	GetDimensions(context.Context, *GetDimensionsRequest) (*GetDimensionsResponse, error)
+	GetPixel(context.Context, *GetPixelRequest) (*GetPixelResponse, error)
+	GetRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
+	ReadBuffer(context.Context, *ReadBufferRequest) (*ReadBufferResponse, error)
+	SetRegion(context.Context, *SetRegionRequest) (*SetRegionResponse, error)
+	SetScale(context.Context, *SetScaleRequest) (*SetScaleResponse, error)
+	SetFont(context.Context, *SetFontRequest) (*SetFontResponse, error)
+	SetContrast(context.Context, *SetContrastRequest) (*SetContrastResponse, error)
+	SetInvert(context.Context, *SetInvertRequest) (*SetInvertResponse, error)
+	SetDisplayOn(context.Context, *SetDisplayOnRequest) (*SetDisplayOnResponse, error)
+	ReadStatus(context.Context, *ReadStatusRequest) (*ReadStatusResponse, error)
+	DetectController(context.Context, *DetectControllerRequest) (*DetectControllerResponse, error)
+	Undo(context.Context, *UndoRequest) (*UndoResponse, error)
+	Redo(context.Context, *RedoRequest) (*RedoResponse, error)
+	Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error)
+	Clear(context.Context, *ClearRequest) (*ClearResponse, error)
+	Reset(context.Context, *ResetRequest) (*ResetResponse, error)
+	DoCommand(context.Context, *DoCommandRequest) (*DoCommandResponse, error)
+	mustEmbedUnimplementedDisplayServiceServer()
+}
+
+// UnimplementedDisplayServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDisplayServiceServer struct {
+}
+
+func (UnimplementedDisplayServiceServer) DisplayBytes(context.Context, *DisplayBytesRequest) (*DisplayBytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisplayBytes not implemented")
+}
+func (UnimplementedDisplayServiceServer) DisplayBytesRLE(context.Context, *DisplayBytesRLERequest) (*DisplayBytesRLEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisplayBytesRLE not implemented")
+}
+func (UnimplementedDisplayServiceServer) DisplayBytesDirty(context.Context, *DisplayBytesDirtyRequest) (*DisplayBytesDirtyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisplayBytesDirty not implemented")
+}
+func (UnimplementedDisplayServiceServer) WriteString(context.Context, *WriteStringRequest) (*WriteStringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteString not implemented")
+}
+func (UnimplementedDisplayServiceServer) WriteStringDouble(context.Context, *WriteStringDoubleRequest) (*WriteStringDoubleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteStringDouble not implemented")
+}
+func (UnimplementedDisplayServiceServer) WriteStringScaled(context.Context, *WriteStringScaledRequest) (*WriteStringScaledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteStringScaled not implemented")
+}
+func (UnimplementedDisplayServiceServer) WriteStringCursor(context.Context, *WriteStringCursorRequest) (*WriteStringCursorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteStringCursor not implemented")
+}
+func (UnimplementedDisplayServiceServer) WriteStringWrapped(context.Context, *WriteStringWrappedRequest) (*WriteStringWrappedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteStringWrapped not implemented")
+}
+func (UnimplementedDisplayServiceServer) WriteStringRotated(context.Context, *WriteStringRotatedRequest) (*WriteStringRotatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteStringRotated not implemented")
+}
+func (UnimplementedDisplayServiceServer) WriteStringVertical(context.Context, *WriteStringVerticalRequest) (*WriteStringVerticalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteStringVertical not implemented")
+}
+func (UnimplementedDisplayServiceServer) WriteStringAlongArc(context.Context, *WriteStringAlongArcRequest) (*WriteStringAlongArcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteStringAlongArc not implemented")
+}
+func (UnimplementedDisplayServiceServer) WriteStringKnockout(context.Context, *WriteStringKnockoutRequest) (*WriteStringKnockoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteStringKnockout not implemented")
+}
+func (UnimplementedDisplayServiceServer) ScrollText(context.Context, *ScrollTextRequest) (*ScrollTextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScrollText not implemented")
+}
+func (UnimplementedDisplayServiceServer) StartScroll(context.Context, *StartScrollRequest) (*StartScrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartScroll not implemented")
+}
+func (UnimplementedDisplayServiceServer) StopScroll(context.Context, *StopScrollRequest) (*StopScrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopScroll not implemented")
+}
+func (UnimplementedDisplayServiceServer) MeasureString(context.Context, *MeasureStringRequest) (*MeasureStringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MeasureString not implemented")
+}
+func (UnimplementedDisplayServiceServer) LogLine(context.Context, *LogLineRequest) (*LogLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogLine not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawPixel(context.Context, *DrawPixelRequest) (*DrawPixelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawPixel not implemented")
+}
+func (UnimplementedDisplayServiceServer) ClearPixel(context.Context, *ClearPixelRequest) (*ClearPixelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearPixel not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawLine(context.Context, *DrawLineRequest) (*DrawLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawLine not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawRect(context.Context, *DrawRectRequest) (*DrawRectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawRect not implemented")
+}
+func (UnimplementedDisplayServiceServer) FillRect(context.Context, *FillRectRequest) (*FillRectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FillRect not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawCircle(context.Context, *DrawCircleRequest) (*DrawCircleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawCircle not implemented")
+}
+func (UnimplementedDisplayServiceServer) FillCircle(context.Context, *FillCircleRequest) (*FillCircleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FillCircle not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawEllipse(context.Context, *DrawEllipseRequest) (*DrawEllipseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawEllipse not implemented")
+}
+func (UnimplementedDisplayServiceServer) FillEllipse(context.Context, *FillEllipseRequest) (*FillEllipseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FillEllipse not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawRoundRect(context.Context, *DrawRoundRectRequest) (*DrawRoundRectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawRoundRect not implemented")
+}
+func (UnimplementedDisplayServiceServer) FillRoundRect(context.Context, *FillRoundRectRequest) (*FillRoundRectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FillRoundRect not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawTriangle(context.Context, *DrawTriangleRequest) (*DrawTriangleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawTriangle not implemented")
+}
+func (UnimplementedDisplayServiceServer) FillTriangle(context.Context, *FillTriangleRequest) (*FillTriangleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FillTriangle not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawBorder(context.Context, *DrawBorderRequest) (*DrawBorderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawBorder not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawGrid(context.Context, *DrawGridRequest) (*DrawGridResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawGrid not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawWaterfall(context.Context, *DrawWaterfallRequest) (*DrawWaterfallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawWaterfall not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawImageRegion(context.Context, *DrawImageRegionRequest) (*DrawImageRegionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawImageRegion not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawBitmap(context.Context, *DrawBitmapRequest) (*DrawBitmapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawBitmap not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawBitmapDithered(context.Context, *DrawBitmapDitheredRequest) (*DrawBitmapDitheredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawBitmapDithered not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawBitmapRLE(context.Context, *DrawBitmapRLERequest) (*DrawBitmapRLEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawBitmapRLE not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawProgressBarLabeled(context.Context, *DrawProgressBarLabeledRequest) (*DrawProgressBarLabeledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawProgressBarLabeled not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawAxes(context.Context, *DrawAxesRequest) (*DrawAxesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawAxes not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawDPad(context.Context, *DrawDPadRequest) (*DrawDPadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawDPad not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawKeyValues(context.Context, *DrawKeyValuesRequest) (*DrawKeyValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawKeyValues not implemented")
+}
+func (UnimplementedDisplayServiceServer) Batch(context.Context, *BatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawTestPattern(context.Context, *DrawTestPatternRequest) (*DrawTestPatternResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawTestPattern not implemented")
+}
+func (UnimplementedDisplayServiceServer) DrawCalibration(context.Context, *DrawCalibrationRequest) (*DrawCalibrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawCalibration not implemented")
+}
+func (UnimplementedDisplayServiceServer) DisplayScaled(context.Context, *DisplayScaledRequest) (*DisplayScaledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisplayScaled not implemented")
+}
+func (UnimplementedDisplayServiceServer) GetDimensions(context.Context, *GetDimensionsRequest) (*GetDimensionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDimensions not implemented")
+}
+func (UnimplementedDisplayServiceServer) GetPixel(context.Context, *GetPixelRequest) (*GetPixelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPixel not implemented")
+}
+func (UnimplementedDisplayServiceServer) GetRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegion not implemented")
+}
+func (UnimplementedDisplayServiceServer) ReadBuffer(context.Context, *ReadBufferRequest) (*ReadBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadBuffer not implemented")
+}
+func (UnimplementedDisplayServiceServer) SetRegion(context.Context, *SetRegionRequest) (*SetRegionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRegion not implemented")
+}
+func (UnimplementedDisplayServiceServer) SetScale(context.Context, *SetScaleRequest) (*SetScaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScale not implemented")
+}
+func (UnimplementedDisplayServiceServer) SetFont(context.Context, *SetFontRequest) (*SetFontResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFont not implemented")
+}
+func (UnimplementedDisplayServiceServer) SetContrast(context.Context, *SetContrastRequest) (*SetContrastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContrast not implemented")
+}
+func (UnimplementedDisplayServiceServer) SetInvert(context.Context, *SetInvertRequest) (*SetInvertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInvert not implemented")
+}
+func (UnimplementedDisplayServiceServer) SetDisplayOn(context.Context, *SetDisplayOnRequest) (*SetDisplayOnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDisplayOn not implemented")
+}
+func (UnimplementedDisplayServiceServer) ReadStatus(context.Context, *ReadStatusRequest) (*ReadStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadStatus not implemented")
+}
+func (UnimplementedDisplayServiceServer) DetectController(context.Context, *DetectControllerRequest) (*DetectControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectController not implemented")
+}
+func (UnimplementedDisplayServiceServer) Undo(context.Context, *UndoRequest) (*UndoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undo not implemented")
+}
+func (UnimplementedDisplayServiceServer) Redo(context.Context, *RedoRequest) (*RedoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redo not implemented")
+}
+func (UnimplementedDisplayServiceServer) Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedDisplayServiceServer) Clear(context.Context, *ClearRequest) (*ClearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clear not implemented")
+}
+func (UnimplementedDisplayServiceServer) Reset(context.Context, *ResetRequest) (*ResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
+}
+func (UnimplementedDisplayServiceServer) DoCommand(context.Context, *DoCommandRequest) (*DoCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoCommand not implemented")
+}
+func (UnimplementedDisplayServiceServer) mustEmbedUnimplementedDisplayServiceServer() {}
+
+// UnsafeDisplayServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DisplayServiceServer will
+// result in compilation errors.
+type UnsafeDisplayServiceServer interface {
+	mustEmbedUnimplementedDisplayServiceServer()
+}
+
+func RegisterDisplayServiceServer(s grpc.ServiceRegistrar, srv DisplayServiceServer) {
+	s.RegisterService(&DisplayService_ServiceDesc, srv)
+}
+
+func _DisplayService_DisplayBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisplayBytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DisplayBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DisplayBytes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DisplayBytes(ctx, req.(*DisplayBytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DisplayBytesRLE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisplayBytesRLERequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DisplayBytesRLE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DisplayBytesRLE_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DisplayBytesRLE(ctx, req.(*DisplayBytesRLERequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DisplayBytesDirty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisplayBytesDirtyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DisplayBytesDirty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DisplayBytesDirty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DisplayBytesDirty(ctx, req.(*DisplayBytesDirtyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_WriteString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteStringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).WriteString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_WriteString_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).WriteString(ctx, req.(*WriteStringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_WriteStringDouble_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteStringDoubleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).WriteStringDouble(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_WriteStringDouble_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).WriteStringDouble(ctx, req.(*WriteStringDoubleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_WriteStringScaled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteStringScaledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).WriteStringScaled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_WriteStringScaled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).WriteStringScaled(ctx, req.(*WriteStringScaledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_WriteStringCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteStringCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).WriteStringCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_WriteStringCursor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).WriteStringCursor(ctx, req.(*WriteStringCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_WriteStringWrapped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteStringWrappedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).WriteStringWrapped(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_WriteStringWrapped_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).WriteStringWrapped(ctx, req.(*WriteStringWrappedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_WriteStringRotated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteStringRotatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).WriteStringRotated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_WriteStringRotated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).WriteStringRotated(ctx, req.(*WriteStringRotatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_WriteStringVertical_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteStringVerticalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).WriteStringVertical(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_WriteStringVertical_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).WriteStringVertical(ctx, req.(*WriteStringVerticalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_WriteStringAlongArc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteStringAlongArcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).WriteStringAlongArc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_WriteStringAlongArc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).WriteStringAlongArc(ctx, req.(*WriteStringAlongArcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_WriteStringKnockout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteStringKnockoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).WriteStringKnockout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_WriteStringKnockout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).WriteStringKnockout(ctx, req.(*WriteStringKnockoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_ScrollText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScrollTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).ScrollText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_ScrollText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).ScrollText(ctx, req.(*ScrollTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_StartScroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartScrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).StartScroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_StartScroll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).StartScroll(ctx, req.(*StartScrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_StopScroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopScrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).StopScroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_StopScroll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).StopScroll(ctx, req.(*StopScrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_MeasureString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MeasureStringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).MeasureString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_MeasureString_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).MeasureString(ctx, req.(*MeasureStringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_LogLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).LogLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_LogLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).LogLine(ctx, req.(*LogLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawPixel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawPixelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawPixel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawPixel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawPixel(ctx, req.(*DrawPixelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_ClearPixel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearPixelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).ClearPixel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_ClearPixel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).ClearPixel(ctx, req.(*ClearPixelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawLine(ctx, req.(*DrawLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawRect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawRectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawRect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawRect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawRect(ctx, req.(*DrawRectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_FillRect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FillRectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).FillRect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_FillRect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).FillRect(ctx, req.(*FillRectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawCircle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawCircleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawCircle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawCircle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawCircle(ctx, req.(*DrawCircleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_FillCircle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FillCircleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).FillCircle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_FillCircle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).FillCircle(ctx, req.(*FillCircleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawEllipse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawEllipseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawEllipse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawEllipse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawEllipse(ctx, req.(*DrawEllipseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_FillEllipse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FillEllipseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).FillEllipse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_FillEllipse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).FillEllipse(ctx, req.(*FillEllipseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawRoundRect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawRoundRectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawRoundRect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawRoundRect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawRoundRect(ctx, req.(*DrawRoundRectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_FillRoundRect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FillRoundRectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).FillRoundRect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_FillRoundRect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).FillRoundRect(ctx, req.(*FillRoundRectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawTriangle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawTriangleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawTriangle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawTriangle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawTriangle(ctx, req.(*DrawTriangleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_FillTriangle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FillTriangleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).FillTriangle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_FillTriangle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).FillTriangle(ctx, req.(*FillTriangleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawBorder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawBorderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawBorder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawBorder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawBorder(ctx, req.(*DrawBorderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawGrid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawGridRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawGrid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawGrid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawGrid(ctx, req.(*DrawGridRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawWaterfall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawWaterfallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawWaterfall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawWaterfall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawWaterfall(ctx, req.(*DrawWaterfallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawImageRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawImageRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawImageRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawImageRegion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawImageRegion(ctx, req.(*DrawImageRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawBitmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawBitmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawBitmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawBitmap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawBitmap(ctx, req.(*DrawBitmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawBitmapDithered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawBitmapDitheredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawBitmapDithered(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawBitmapDithered_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawBitmapDithered(ctx, req.(*DrawBitmapDitheredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawBitmapRLE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawBitmapRLERequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawBitmapRLE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawBitmapRLE_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawBitmapRLE(ctx, req.(*DrawBitmapRLERequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawProgressBarLabeled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawProgressBarLabeledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawProgressBarLabeled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawProgressBarLabeled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawProgressBarLabeled(ctx, req.(*DrawProgressBarLabeledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawAxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawAxesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawAxes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawAxes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawAxes(ctx, req.(*DrawAxesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawDPad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawDPadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawDPad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawDPad_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawDPad(ctx, req.(*DrawDPadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawKeyValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawKeyValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawKeyValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawKeyValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawKeyValues(ctx, req.(*DrawKeyValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_Batch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).Batch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawTestPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawTestPatternRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawTestPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawTestPattern_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawTestPattern(ctx, req.(*DrawTestPatternRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DrawCalibration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawCalibrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DrawCalibration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DrawCalibration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DrawCalibration(ctx, req.(*DrawCalibrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_DisplayScaled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisplayScaledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DisplayScaled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DisplayScaled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DisplayScaled(ctx, req.(*DisplayScaledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_GetDimensions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDimensionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).GetDimensions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_GetDimensions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).GetDimensions(ctx, req.(*GetDimensionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_GetPixel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPixelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).GetPixel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_GetPixel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).GetPixel(ctx, req.(*GetPixelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_GetRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).GetRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_GetRegion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).GetRegion(ctx, req.(*GetRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_ReadBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadBufferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).ReadBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_ReadBuffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).ReadBuffer(ctx, req.(*ReadBufferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_SetRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).SetRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_SetRegion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).SetRegion(ctx, req.(*SetRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisplayService_SetScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).SetScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_SetScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).SetScale(ctx, req.(*SetScaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *displayServiceClient) DrawLine(ctx context.Context, in *DrawLineRequest, opts ...grpc.CallOption) (*DrawLineResponse, error) {
-	out := new(DrawLineResponse)
-	err := c.cc.Invoke(ctx, DisplayService_DrawLine_FullMethodName, in, out, opts...)
-	if err != nil {
+func _DisplayService_SetFont_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFontRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).SetFont(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_SetFont_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).SetFont(ctx, req.(*SetFontRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *displayServiceClient) Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetResponse, error) {
-	out := new(ResetResponse)
-	err := c.cc.Invoke(ctx, DisplayService_Reset_FullMethodName, in, out, opts...)
-	if err != nil {
+func _DisplayService_SetContrast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContrastRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).SetContrast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_SetContrast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).SetContrast(ctx, req.(*SetContrastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *displayServiceClient) DoCommand(ctx context.Context, in *DoCommandRequest, opts ...grpc.CallOption) (*DoCommandResponse, error) {
-	out := new(DoCommandResponse)
-	err := c.cc.Invoke(ctx, DisplayService_DoCommand_FullMethodName, in, out, opts...)
-	if err != nil {
+func _DisplayService_SetInvert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInvertRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// DisplayServiceServer is the server API for DisplayService service.
-// All implementations must embed UnimplementedDisplayServiceServer
-// for forward compatibility
-type DisplayServiceServer interface {
-	DisplayBytes(context.Context, *DisplayBytesRequest) (*DisplayBytesResponse, error)
-	WriteString(context.Context, *WriteStringRequest) (*WriteStringResponse, error)
-	DrawLine(context.Context, *DrawLineRequest) (*DrawLineResponse, error)
-	Reset(context.Context, *ResetRequest) (*ResetResponse, error)
-	DoCommand(context.Context, *DoCommandRequest) (*DoCommandResponse, error)
-	mustEmbedUnimplementedDisplayServiceServer()
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).SetInvert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_SetInvert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).SetInvert(ctx, req.(*SetInvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedDisplayServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedDisplayServiceServer struct {
+func _DisplayService_SetDisplayOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDisplayOnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).SetDisplayOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_SetDisplayOn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).SetDisplayOn(ctx, req.(*SetDisplayOnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedDisplayServiceServer) DisplayBytes(context.Context, *DisplayBytesRequest) (*DisplayBytesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisplayBytes not implemented")
-}
-func (UnimplementedDisplayServiceServer) WriteString(context.Context, *WriteStringRequest) (*WriteStringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteString not implemented")
-}
-func (UnimplementedDisplayServiceServer) DrawLine(context.Context, *DrawLineRequest) (*DrawLineResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DrawLine not implemented")
-}
-func (UnimplementedDisplayServiceServer) Reset(context.Context, *ResetRequest) (*ResetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
-}
-func (UnimplementedDisplayServiceServer) DoCommand(context.Context, *DoCommandRequest) (*DoCommandResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DoCommand not implemented")
+func _DisplayService_ReadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).ReadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_ReadStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).ReadStatus(ctx, req.(*ReadStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDisplayServiceServer) mustEmbedUnimplementedDisplayServiceServer() {}
 
-// UnsafeDisplayServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to DisplayServiceServer will
-// result in compilation errors.
-type UnsafeDisplayServiceServer interface {
-	mustEmbedUnimplementedDisplayServiceServer()
+func _DisplayService_DetectController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).DetectController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_DetectController_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).DetectController(ctx, req.(*DetectControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterDisplayServiceServer(s grpc.ServiceRegistrar, srv DisplayServiceServer) {
-	s.RegisterService(&DisplayService_ServiceDesc, srv)
+func _DisplayService_Undo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisplayServiceServer).Undo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DisplayService_Undo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisplayServiceServer).Undo(ctx, req.(*UndoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _DisplayService_DisplayBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DisplayBytesRequest)
+func _DisplayService_Redo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DisplayServiceServer).DisplayBytes(ctx, in)
+		return srv.(DisplayServiceServer).Redo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DisplayService_DisplayBytes_FullMethodName,
+		FullMethod: DisplayService_Redo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DisplayServiceServer).DisplayBytes(ctx, req.(*DisplayBytesRequest))
+		return srv.(DisplayServiceServer).Redo(ctx, req.(*RedoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DisplayService_WriteString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WriteStringRequest)
+func _DisplayService_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DisplayServiceServer).WriteString(ctx, in)
+		return srv.(DisplayServiceServer).Refresh(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DisplayService_WriteString_FullMethodName,
+		FullMethod: DisplayService_Refresh_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DisplayServiceServer).WriteString(ctx, req.(*WriteStringRequest))
+		return srv.(DisplayServiceServer).Refresh(ctx, req.(*RefreshRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DisplayService_DrawLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DrawLineRequest)
+func _DisplayService_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DisplayServiceServer).DrawLine(ctx, in)
+		return srv.(DisplayServiceServer).Clear(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DisplayService_DrawLine_FullMethodName,
+		FullMethod: DisplayService_Clear_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DisplayServiceServer).DrawLine(ctx, req.(*DrawLineRequest))
+		return srv.(DisplayServiceServer).Clear(ctx, req.(*ClearRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -235,14 +2149,246 @@ var DisplayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisplayBytes",
 			Handler:    _DisplayService_DisplayBytes_Handler,
 		},
+		{
+			MethodName: "DisplayBytesRLE",
+			Handler:    _DisplayService_DisplayBytesRLE_Handler,
+		},
+		{
+			MethodName: "DisplayBytesDirty",
+			Handler:    _DisplayService_DisplayBytesDirty_Handler,
+		},
 		{
 			MethodName: "WriteString",
 			Handler:    _DisplayService_WriteString_Handler,
 		},
+		{
+			MethodName: "WriteStringDouble",
+			Handler:    _DisplayService_WriteStringDouble_Handler,
+		},
+		{
+			MethodName: "WriteStringScaled",
+			Handler:    _DisplayService_WriteStringScaled_Handler,
+		},
+		{
+			MethodName: "WriteStringCursor",
+			Handler:    _DisplayService_WriteStringCursor_Handler,
+		},
+		{
+			MethodName: "WriteStringWrapped",
+			Handler:    _DisplayService_WriteStringWrapped_Handler,
+		},
+		{
+			MethodName: "WriteStringRotated",
+			Handler:    _DisplayService_WriteStringRotated_Handler,
+		},
+		{
+			MethodName: "WriteStringVertical",
+			Handler:    _DisplayService_WriteStringVertical_Handler,
+		},
+		{
+			MethodName: "WriteStringAlongArc",
+			Handler:    _DisplayService_WriteStringAlongArc_Handler,
+		},
+		{
+			MethodName: "WriteStringKnockout",
+			Handler:    _DisplayService_WriteStringKnockout_Handler,
+		},
+		{
+			MethodName: "ScrollText",
+			Handler:    _DisplayService_ScrollText_Handler,
+		},
+		{
+			MethodName: "StartScroll",
+			Handler:    _DisplayService_StartScroll_Handler,
+		},
+		{
+			MethodName: "StopScroll",
+			Handler:    _DisplayService_StopScroll_Handler,
+		},
+		{
+			MethodName: "MeasureString",
+			Handler:    _DisplayService_MeasureString_Handler,
+		},
+		{
+			MethodName: "LogLine",
+			Handler:    _DisplayService_LogLine_Handler,
+		},
+		{
+			MethodName: "DrawPixel",
+			Handler:    _DisplayService_DrawPixel_Handler,
+		},
+		{
+			MethodName: "ClearPixel",
+			Handler:    _DisplayService_ClearPixel_Handler,
+		},
 		{
 			MethodName: "DrawLine",
 			Handler:    _DisplayService_DrawLine_Handler,
 		},
+		{
+			MethodName: "DrawRect",
+			Handler:    _DisplayService_DrawRect_Handler,
+		},
+		{
+			MethodName: "FillRect",
+			Handler:    _DisplayService_FillRect_Handler,
+		},
+		{
+			MethodName: "DrawCircle",
+			Handler:    _DisplayService_DrawCircle_Handler,
+		},
+		{
+			MethodName: "FillCircle",
+			Handler:    _DisplayService_FillCircle_Handler,
+		},
+		{
+			MethodName: "DrawEllipse",
+			Handler:    _DisplayService_DrawEllipse_Handler,
+		},
+		{
+			MethodName: "FillEllipse",
+			Handler:    _DisplayService_FillEllipse_Handler,
+		},
+		{
+			MethodName: "DrawRoundRect",
+			Handler:    _DisplayService_DrawRoundRect_Handler,
+		},
+		{
+			MethodName: "FillRoundRect",
+			Handler:    _DisplayService_FillRoundRect_Handler,
+		},
+		{
+			MethodName: "DrawTriangle",
+			Handler:    _DisplayService_DrawTriangle_Handler,
+		},
+		{
+			MethodName: "FillTriangle",
+			Handler:    _DisplayService_FillTriangle_Handler,
+		},
+		{
+			MethodName: "DrawBorder",
+			Handler:    _DisplayService_DrawBorder_Handler,
+		},
+		{
+			MethodName: "DrawGrid",
+			Handler:    _DisplayService_DrawGrid_Handler,
+		},
+		{
+			MethodName: "DrawWaterfall",
+			Handler:    _DisplayService_DrawWaterfall_Handler,
+		},
+		{
+			MethodName: "DrawImageRegion",
+			Handler:    _DisplayService_DrawImageRegion_Handler,
+		},
+		{
+			MethodName: "DrawBitmap",
+			Handler:    _DisplayService_DrawBitmap_Handler,
+		},
+		{
+			MethodName: "DrawBitmapDithered",
+			Handler:    _DisplayService_DrawBitmapDithered_Handler,
+		},
+		{
+			MethodName: "DrawBitmapRLE",
+			Handler:    _DisplayService_DrawBitmapRLE_Handler,
+		},
+		{
+			MethodName: "DrawProgressBarLabeled",
+			Handler:    _DisplayService_DrawProgressBarLabeled_Handler,
+		},
+		{
+			MethodName: "DrawAxes",
+			Handler:    _DisplayService_DrawAxes_Handler,
+		},
+		{
+			MethodName: "DrawDPad",
+			Handler:    _DisplayService_DrawDPad_Handler,
+		},
+		{
+			MethodName: "DrawKeyValues",
+			Handler:    _DisplayService_DrawKeyValues_Handler,
+		},
+		{
+			MethodName: "Batch",
+			Handler:    _DisplayService_Batch_Handler,
+		},
+		{
+			MethodName: "DrawTestPattern",
+			Handler:    _DisplayService_DrawTestPattern_Handler,
+		},
+		{
+			MethodName: "DrawCalibration",
+			Handler:    _DisplayService_DrawCalibration_Handler,
+		},
+		{
+			MethodName: "DisplayScaled",
+			Handler:    _DisplayService_DisplayScaled_Handler,
+		},
+		{
+			MethodName: "GetDimensions",
+			Handler:    _DisplayService_GetDimensions_Handler,
+		},
+		{
+			MethodName: "GetPixel",
+			Handler:    _DisplayService_GetPixel_Handler,
+		},
+		{
+			MethodName: "GetRegion",
+			Handler:    _DisplayService_GetRegion_Handler,
+		},
+		{
+			MethodName: "ReadBuffer",
+			Handler:    _DisplayService_ReadBuffer_Handler,
+		},
+		{
+			MethodName: "SetRegion",
+			Handler:    _DisplayService_SetRegion_Handler,
+		},
+		{
+			MethodName: "SetScale",
+			Handler:    _DisplayService_SetScale_Handler,
+		},
+		{
+			MethodName: "SetFont",
+			Handler:    _DisplayService_SetFont_Handler,
+		},
+		{
+			MethodName: "SetContrast",
+			Handler:    _DisplayService_SetContrast_Handler,
+		},
+		{
+			MethodName: "SetInvert",
+			Handler:    _DisplayService_SetInvert_Handler,
+		},
+		{
+			MethodName: "SetDisplayOn",
+			Handler:    _DisplayService_SetDisplayOn_Handler,
+		},
+		{
+			MethodName: "ReadStatus",
+			Handler:    _DisplayService_ReadStatus_Handler,
+		},
+		{
+			MethodName: "DetectController",
+			Handler:    _DisplayService_DetectController_Handler,
+		},
+		{
+			MethodName: "Undo",
+			Handler:    _DisplayService_Undo_Handler,
+		},
+		{
+			MethodName: "Redo",
+			Handler:    _DisplayService_Redo_Handler,
+		},
+		{
+			MethodName: "Refresh",
+			Handler:    _DisplayService_Refresh_Handler,
+		},
+		{
+			MethodName: "Clear",
+			Handler:    _DisplayService_Clear_Handler,
+		},
 		{
 			MethodName: "Reset",
 			Handler:    _DisplayService_Reset_Handler,
@@ -0,0 +1,106 @@
+package display
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/biotinker/viam-i2c-display/display/api/displayapi"
+)
+
+// Batch draws ops in order onto the current frame and sends the result once, so a client can compose a whole screen
+// in one call. Every op is checked first, and nothing is drawn if any of them is invalid.
+func (d *display) Batch(ctx context.Context, ops []displayapi.DrawOp) error {
+	for i, op := range ops {
+		if err := checkDrawOp(op); err != nil {
+			return fmt.Errorf("op %d: %w", i, err)
+		}
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		for _, op := range ops {
+			buf = d.drawOp(op, buf)
+		}
+		return buf
+	})
+}
+
+func checkDrawOp(op displayapi.DrawOp) error {
+	switch op.Op {
+	case "pixel", "line", "text":
+	case "rect", "fill_rect":
+		if op.W < 0 || op.H < 0 {
+			return fmt.Errorf("rect width and height must not be negative, got %dx%d", op.W, op.H)
+		}
+	default:
+		return fmt.Errorf(`unknown op %q, expected "pixel", "line", "rect", "fill_rect" or "text"`, op.Op)
+	}
+	return nil
+}
+
+// drawOp draws a checked op into buf. Callers hold d.mu.
+func (d *display) drawOp(op displayapi.DrawOp, buf []byte) []byte {
+	switch op.Op {
+	case "pixel":
+		return d.geom.WritePixel(op.X, op.Y, buf)
+	case "line":
+		return d.geom.WriteLine(op.X, op.Y, op.X2, op.Y2, buf)
+	case "rect":
+		return d.geom.WriteRect(op.X, op.Y, op.W, op.H, buf)
+	case "fill_rect":
+		return d.geom.WriteFillRect(op.X, op.Y, op.W, op.H, buf)
+	default:
+		return d.geom.WriteString(op.X, d.baseline(op.Y), op.Text, buf)
+	}
+}
+
+// holdPixel holds one pixel back instead of sending it. The held pixels are sent together once pixel_batch of them
+// have been drawn, or by a timer pixel_batch_ms after the first, so a client plotting point by point doesn't pay for a
+// transfer per pixel. They're kept as draws rather than a frame, so whatever else changes the screen in the meantime
+// is drawn under them, and the next draw sends them along with its own pixels.
+func (d *display) holdPixel(ctx context.Context, fn func(buf []byte) []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	// with clip_mode "error", a pixel off the screen is turned away now rather than failing the batch later
+	if _, err := d.render(fn); err != nil {
+		return err
+	}
+	if len(d.heldPixels) == 0 && d.pixelBatchDelay > 0 {
+		d.heldTimer = time.AfterFunc(d.pixelBatchDelay, d.sendHeldPixels)
+	}
+	d.heldPixels = append(d.heldPixels, fn)
+	if d.pixelBatch > 0 && len(d.heldPixels) >= d.pixelBatch {
+		return d.flushHeldPixels(ctx)
+	}
+	return nil
+}
+
+// sendHeldPixels is the pixel_batch_ms timer, which sends the pixels held since it was started.
+func (d *display) sendHeldPixels() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.flushHeldPixels(context.Background()); err != nil {
+		d.logger.Warnw("couldn't send the batched pixels", "error", err)
+	}
+}
+
+// flushHeldPixels sends the pixels DrawPixel is holding back, if there are any. Callers hold d.mu.
+func (d *display) flushHeldPixels(ctx context.Context) error {
+	if len(d.heldPixels) == 0 {
+		return nil
+	}
+	frame, err := d.render(func(buf []byte) []byte { return buf })
+	if err != nil {
+		return err
+	}
+	return d.flush(ctx, frame)
+}
+
+// releaseHeldPixels forgets the held pixels once a frame has been sent that has them or covers them. Callers hold
+// d.mu.
+func (d *display) releaseHeldPixels() {
+	if d.heldTimer != nil {
+		d.heldTimer.Stop()
+		d.heldTimer = nil
+	}
+	d.heldPixels = nil
+}
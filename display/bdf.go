@@ -0,0 +1,121 @@
+package display
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ParseBDF reads a font in the X11 BDF format. Only the printable ASCII characters are kept, as for the built in
+// font; any of them the file doesn't define are drawn as nothing and don't advance.
+func ParseBDF(r io.Reader) (*Font, error) {
+	const first, last = 0x20, 0x7E
+	font := &Font{First: first, Glyphs: make([][]int, last-first+1)}
+	for i := range font.Glyphs {
+		font.Glyphs[i] = []int{0, 0, 0, 0, 0, 0}
+	}
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	var (
+		encoding              = -1
+		adv, w, h, xoff, yoff int
+		rows                  []string
+		inBitmap              bool
+	)
+	for scanner.Scan() {
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if inBitmap && fields[0] != "ENDCHAR" {
+			rows = append(rows, fields[0])
+			continue
+		}
+		var err error
+		switch fields[0] {
+		case "STARTCHAR":
+			encoding, adv, w, h, xoff, yoff, rows = -1, 0, 0, 0, 0, 0, nil
+		case "ENCODING":
+			err = bdfInts(fields, &encoding)
+		case "DWIDTH":
+			err = bdfInts(fields, &adv)
+		case "BBX":
+			err = bdfInts(fields, &w, &h, &xoff, &yoff)
+		case "BITMAP":
+			inBitmap = true
+		case "ENDCHAR":
+			inBitmap = false
+			if encoding < first || encoding > last {
+				continue
+			}
+			if len(rows) != h {
+				return nil, fmt.Errorf("line %d: character %d has %d bitmap rows, its BBX says %d",
+					line, encoding, len(rows), h)
+			}
+			offset := len(font.Bitmap)
+			if font.Bitmap, err = packBDFRows(font.Bitmap, rows, w); err != nil {
+				return nil, fmt.Errorf("line %d: character %d: %w", line, encoding, err)
+			}
+			// BDF measures the bottom row up from the baseline, GFX measures the top row down to it
+			font.Glyphs[encoding-first] = []int{offset, w, h, adv, xoff, -(yoff + h - 1)}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := font.validate(); err != nil {
+		return nil, err
+	}
+	return font, nil
+}
+
+// bdfInts parses the integers after a BDF keyword into dst.
+func bdfInts(fields []string, dst ...*int) error {
+	if len(fields)-1 < len(dst) {
+		return fmt.Errorf("%s needs %d values, got %d", fields[0], len(dst), len(fields)-1)
+	}
+	for i, d := range dst {
+		v, err := strconv.Atoi(fields[i+1])
+		if err != nil {
+			return fmt.Errorf("%s: %w", fields[0], err)
+		}
+		*d = v
+	}
+	return nil
+}
+
+// packBDFRows appends the glyph in rows, one hex string per row padded to whole bytes, to bitmap as a continuous run
+// of w bits per row the way GFX fonts store glyphs.
+func packBDFRows(bitmap []byte, rows []string, w int) ([]byte, error) {
+	var cur byte
+	n := 0
+	for _, row := range rows {
+		raw, err := hex.DecodeString(row)
+		if err != nil {
+			return nil, fmt.Errorf("bad bitmap row %q: %w", row, err)
+		}
+		if len(raw)*8 < w {
+			return nil, fmt.Errorf("bitmap row %q is narrower than %d pixels", row, w)
+		}
+		for x := 0; x < w; x++ {
+			cur = cur<<1 | raw[x/8]>>(7-x%8)&1
+			n++
+			if n%8 == 0 {
+				bitmap = append(bitmap, cur)
+				cur = 0
+			}
+		}
+	}
+	if n%8 != 0 {
+		bitmap = append(bitmap, cur<<(8-n%8))
+	}
+	return bitmap, nil
+}
@@ -0,0 +1,179 @@
+package display
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.viam.com/utils"
+)
+
+// BootSequence is the boot_sequence attribute: a splash bitmap shown at startup in place of the loading bar
+// animation, which then gives way to the cleared screen.
+type BootSequence struct {
+	// Splash is a Width x Height bitmap encoded as for DrawBitmapRLE (base64 in JSON), shown centered.
+	Splash []byte `json:"splash"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	// DurationMs is how long the splash stays up before the transition, defaultSplashDuration if unset.
+	DurationMs int `json:"duration_ms,omitempty"`
+	// Transition is "cut" (the default), "wipe" or "fade", see transitionCut.
+	Transition string `json:"transition,omitempty"`
+}
+
+// defaultSplashDuration is how long the splash is shown if duration_ms isn't set.
+const defaultSplashDuration = 2 * time.Second
+
+// Supported values for the boot sequence's transition.
+const (
+	transitionCut  = "cut"  // clear the screen in one go
+	transitionWipe = "wipe" // clear it page by page, left to right
+	transitionFade = "fade" // ramp the contrast down, clear, then put the contrast back
+)
+
+// The wipe and fade transitions take a step every bootStepDelay, and a fade takes fadeSteps of them.
+const (
+	bootStepDelay = 30 * time.Millisecond
+	fadeSteps     = 8
+)
+
+// validate checks the boot sequence for a screen of screenW x screenH pixels, which the splash has to fit.
+func (b *BootSequence) validate(screenW, screenH int) error {
+	if b.Width <= 0 || b.Height <= 0 || b.Width > screenW || b.Height > screenH {
+		return fmt.Errorf("boot_sequence width and height must be between 1x1 and the %dx%d screen, got %dx%d",
+			screenW, screenH, b.Width, b.Height)
+	}
+	if _, err := DecodeBitmapRLE(b.Width, b.Height, b.Splash); err != nil {
+		return fmt.Errorf("boot_sequence splash: %w", err)
+	}
+	if b.DurationMs < 0 {
+		return fmt.Errorf("boot_sequence duration_ms must not be negative, got %d", b.DurationMs)
+	}
+	switch b.Transition {
+	case "", transitionCut, transitionWipe, transitionFade:
+	default:
+		return fmt.Errorf("boot_sequence transition must be %q, %q or %q, got %q",
+			transitionCut, transitionWipe, transitionFade, b.Transition)
+	}
+	return nil
+}
+
+// startBoot puts the splash up and leaves a goroutine to take it down after its duration, which Close stops. The
+// splash is never the current frame, so a draw that comes in first starts from the cleared screen and ends the
+// sequence (see endSplash).
+func (d *display) startBoot(ctx context.Context, boot *BootSequence) {
+	// the config was validated, so the splash decodes
+	splash, _ := DecodeBitmapRLE(boot.Width, boot.Height, boot.Splash)
+	x, y := (d.geom.Width-boot.Width)/2, (d.geom.Height-boot.Height)/2
+	frame := d.geom.WriteRegion(x, y, boot.Width, boot.Height, splash, d.geom.Blank())
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.showSplash(ctx, frame); err != nil {
+		d.logger.Warnw("couldn't show the boot splash", "error", err)
+		return
+	}
+	d.splashUp = true
+
+	duration := defaultSplashDuration
+	if boot.DurationMs > 0 {
+		duration = time.Duration(boot.DurationMs) * time.Millisecond
+	}
+	// the constructor's context ends when it returns, so the rest of the sequence gets its own
+	bootCtx, cancel := context.WithCancel(context.Background())
+	d.cancelBoot = cancel
+	d.bootWorkers.Add(1)
+	go func() {
+		defer d.bootWorkers.Done()
+		d.finishBoot(bootCtx, duration, boot.Transition, frame)
+	}()
+}
+
+// finishBoot waits out the splash and runs the transition to the cleared screen, giving up as soon as a draw has
+// taken the screen over or ctx is done.
+func (d *display) finishBoot(ctx context.Context, duration time.Duration, transition string, frame []byte) {
+	if !utils.SelectContextOrWait(ctx, duration) {
+		return
+	}
+	switch transition {
+	case transitionWipe:
+		cleared := d.cleared()
+		h := d.geom.Height
+		for page := 0; page < d.geom.pages(); page++ {
+			copy(frame[page*h:(page+1)*h], cleared[page*h:(page+1)*h])
+			if !d.bootStep(ctx, func() error { return d.showSplash(ctx, frame) }) {
+				return
+			}
+			if !utils.SelectContextOrWait(ctx, bootStepDelay) {
+				return
+			}
+		}
+	case transitionFade:
+		for step := 1; step <= fadeSteps; step++ {
+			if !d.bootStep(ctx, func() error {
+				level := int(d.contrast) * (fadeSteps - step) / fadeSteps
+				return d.sendCommand(ctx, sh110xSETCONTRAST, byte(level))
+			}) {
+				return
+			}
+			if !utils.SelectContextOrWait(ctx, bootStepDelay) {
+				return
+			}
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.splashUp {
+		return
+	}
+	d.splashUp = false
+	if err := d.writeBuf(ctx, d.current); err != nil {
+		d.logger.Warnw("couldn't clear the boot splash", "error", err)
+	}
+	if transition == transitionFade {
+		d.sendCommand(ctx, sh110xSETCONTRAST, d.contrast)
+	}
+}
+
+// bootStep runs one step of the boot transition under mu, and reports whether the sequence should go on.
+func (d *display) bootStep(ctx context.Context, step func() error) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.splashUp {
+		return false
+	}
+	if err := step(); err != nil {
+		d.logger.Warnw("boot sequence transition failed", "error", err)
+	}
+	return true
+}
+
+// showSplash sends frame to the panel without making it the current frame. Callers hold d.mu.
+func (d *display) showSplash(ctx context.Context, frame []byte) error {
+	current := d.current
+	defer func() { d.current = current }()
+	return d.writeBuf(ctx, frame)
+}
+
+// endSplash cuts the boot sequence short when something else is drawn, and reports whether it did, in which case the
+// caller must send the whole frame to cover the splash. A fade may have dimmed the panel, so the contrast is put
+// back. Callers hold d.mu.
+func (d *display) endSplash(ctx context.Context) bool {
+	if !d.splashUp {
+		return false
+	}
+	d.splashUp = false
+	d.sendCommand(ctx, sh110xSETCONTRAST, d.contrast)
+	return true
+}
+
+// sendCommand sends controller commands in a single transfer.
+func (d *display) sendCommand(ctx context.Context, cmds ...byte) error {
+	handle, err := d.bus.OpenHandle(d.addr)
+	if err != nil {
+		return err
+	}
+	defer utils.UncheckedErrorFunc(handle.Close)
+	return d.write(ctx, handle, d.command(cmds...))
+}
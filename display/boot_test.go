@@ -0,0 +1,71 @@
+package display
+
+import (
+	"context"
+	"math/bits"
+	"testing"
+	"time"
+
+	"go.viam.com/test"
+)
+
+// litSplash is a boot sequence whose 8x8 splash is all on.
+func litSplash(durationMs int) *BootSequence {
+	lit := []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	return &BootSequence{Splash: EncodeBitmapRLE(8, 8, lit), Width: 8, Height: 8, DurationMs: durationMs}
+}
+
+// litPixels counts the pixels that are on in pages.
+func litPixels(pages map[int][]byte) int {
+	n := 0
+	for _, data := range pages {
+		for _, b := range data {
+			n += bits.OnesCount8(b)
+		}
+	}
+	return n
+}
+
+func splashUp(d *display) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.splashUp
+}
+
+func TestBootSequence(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("cleared after its duration", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{BootSequence: litSplash(10)}, true)
+		test.That(t, litPixels(sentPages(bus)), test.ShouldEqual, 64)
+		bus.ClearTransfers()
+		deadline := time.Now().Add(5 * time.Second)
+		for splashUp(d) && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		test.That(t, splashUp(d), test.ShouldBeFalse)
+		test.That(t, d.Close(ctx), test.ShouldBeNil)
+		pages := sentPages(bus)
+		test.That(t, pages, test.ShouldHaveLength, d.geom.pages())
+		test.That(t, litPixels(pages), test.ShouldEqual, 0)
+	})
+
+	t.Run("ended by a draw", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{BootSequence: litSplash(60000)}, false)
+		test.That(t, d.DrawPixel(ctx, 0, 0), test.ShouldBeNil)
+		test.That(t, splashUp(d), test.ShouldBeFalse)
+		// the whole frame goes to cover the splash, with only the new pixel on
+		pages := sentPages(bus)
+		test.That(t, pages, test.ShouldHaveLength, d.geom.pages())
+		test.That(t, litPixels(pages), test.ShouldEqual, 1)
+	})
+
+	t.Run("stopped by Close", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{BootSequence: litSplash(50)}, false)
+		test.That(t, d.Close(ctx), test.ShouldBeNil)
+		time.Sleep(150 * time.Millisecond)
+		// the splash is left for the next display to replace
+		test.That(t, splashUp(d), test.ShouldBeTrue)
+		test.That(t, transferData(bus), test.ShouldBeEmpty)
+	})
+}
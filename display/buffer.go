@@ -0,0 +1,582 @@
+package display
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"math"
+)
+
+// Geometry is the size in pixels of a page-packed frame buffer, the format the display keeps in memory and accepts
+// through DisplayBytes. (0,0) is the bottom left corner, x runs to the right along Width and y runs up along Height.
+// Each byte holds 8 horizontally adjacent pixels, lowest bit leftmost, and a page of Height bytes covers one 8 pixel
+// wide column band of the screen.
+//
+// The Write* helpers draw into a buffer of this geometry and return it, so Go clients can render a frame offscreen
+// with the same code the display uses and then send it in one DisplayBytes call. Coordinates outside the buffer wrap
+// around to the other side, unless Clip is set.
+type Geometry struct {
+	Width  int
+	Height int
+	// Scale zooms everything the Write* helpers draw about the origin, each pixel becoming a Scale x Scale block
+	// (nearest neighbour, so fractional factors give blocks of uneven size). 0 means 1.
+	Scale float64
+	// Font is what text is written in, FreeMono Bold 18pt if nil.
+	Font *Font
+	// Clip drops pixels drawn outside the buffer instead of wrapping them around.
+	Clip bool
+	// clipped, if set, counts the pixels Clip drops, for the display's clip_mode "error" and report_clipped.
+	clipped *int
+}
+
+// defaultGeometry is the 128x64 panel this module was written for.
+var defaultGeometry = Geometry{Width: 128, Height: 64}
+
+// NewBuffer returns a blank frame buffer for a width x height panel.
+func NewBuffer(width, height int) []byte {
+	return Geometry{Width: width, Height: height}.Blank()
+}
+
+// Blank returns a frame buffer of this geometry with every pixel off.
+func (g Geometry) Blank() []byte {
+	return make([]byte, g.Height*((g.Width+7)/8))
+}
+
+// WritePixel turns on the pixel at (x, y).
+func (g Geometry) WritePixel(x, y int, buf []byte) []byte {
+	g.forEachScaled(x, y, func(x, y int) {
+		buf[g.index(x, y)] |= 1 << (x & 7)
+	})
+	return buf
+}
+
+// ClearPixel turns off the pixel at (x, y).
+func (g Geometry) ClearPixel(x, y int, buf []byte) []byte {
+	g.forEachScaled(x, y, func(x, y int) {
+		buf[g.index(x, y)] &^= 1 << (x & 7)
+	})
+	return buf
+}
+
+// Pixel reports whether the pixel at (x, y) is on. With a Scale set, that's the bottom left screen pixel of its block.
+func (g Geometry) Pixel(x, y int, buf []byte) bool {
+	if g.scaled() {
+		x, y = int(math.Floor(float64(x)*g.Scale)), int(math.Floor(float64(y)*g.Scale))
+	}
+	if g.Clip && !g.onScreen(x, y) {
+		return false
+	}
+	x, y = g.wrap(x, y)
+	return buf[g.index(x, y)]&(1<<(x&7)) != 0
+}
+
+func (g Geometry) scaled() bool {
+	return g.Scale != 0 && g.Scale != 1
+}
+
+// forEachScaled calls fn with every on-screen pixel the pixel (x, y) covers after scaling, which is at least one
+// unless the pixel is clipped.
+func (g Geometry) forEachScaled(x, y int, fn func(x, y int)) {
+	if !g.scaled() {
+		g.place(x, y, fn)
+		return
+	}
+	x0, x1 := scaleSpan(x, g.Scale)
+	y0, y1 := scaleSpan(y, g.Scale)
+	for sy := y0; sy <= y1; sy++ {
+		for sx := x0; sx <= x1; sx++ {
+			g.place(sx, sy, fn)
+		}
+	}
+}
+
+// scaleSpan returns the first and last screen coordinate covered by coordinate v at the given scale.
+func scaleSpan(v int, scale float64) (int, int) {
+	first := int(math.Floor(float64(v) * scale))
+	last := int(math.Floor(float64(v+1)*scale)) - 1
+	if last < first {
+		last = first
+	}
+	return first, last
+}
+
+// place calls fn with the on-screen pixel that (x, y) lands on, wrapped around if it's off the screen, or not at all
+// if it's off the screen and clipped.
+func (g Geometry) place(x, y int, fn func(x, y int)) {
+	if !g.Clip {
+		fn(g.wrap(x, y))
+		return
+	}
+	if !g.onScreen(x, y) {
+		if g.clipped != nil {
+			*g.clipped++
+		}
+		return
+	}
+	fn(x, y)
+}
+
+func (g Geometry) onScreen(x, y int) bool {
+	return x >= 0 && x < g.Width && y >= 0 && y < g.Height
+}
+
+// wrap maps coordinates outside the geometry back onto it.
+func (g Geometry) wrap(x, y int) (int, int) {
+	x %= g.Width
+	if x < 0 {
+		x += g.Width
+	}
+	y %= g.Height
+	if y < 0 {
+		y += g.Height
+	}
+	return x, y
+}
+
+// pages is how many 8 pixel wide column bands the frame is sent to the panel in.
+func (g Geometry) pages() int {
+	return (g.Width + 7) / 8
+}
+
+// changedPages lists the pages from first through last that differ between the frames a and b.
+func (g Geometry) changedPages(a, b []byte, first, last int) []int {
+	var pages []int
+	for page := first; page <= last; page++ {
+		start, end := page*g.Height, (page+1)*g.Height
+		if !bytes.Equal(a[start:end], b[start:end]) {
+			pages = append(pages, page)
+		}
+	}
+	return pages
+}
+
+// pageRange lists the pages first through last.
+func pageRange(first, last int) []int {
+	pages := make([]int, 0, last-first+1)
+	for page := first; page <= last; page++ {
+		pages = append(pages, page)
+	}
+	return pages
+}
+
+// index returns the offset of the byte holding the on-screen pixel (x, y).
+func (g Geometry) index(x, y int) int {
+	return y + (x/8)*g.Height
+}
+
+// ChangedBounds returns the smallest rectangle holding every pixel that differs between the frames a and b, in screen
+// pixels with Min at its bottom left, or an empty rectangle if they're the same.
+func (g Geometry) ChangedBounds(a, b []byte) image.Rectangle {
+	var bounds image.Rectangle
+	for i := range a {
+		diff := a[i] ^ b[i]
+		if diff == 0 {
+			continue
+		}
+		y, page := i%g.Height, i/g.Height
+		for bit := 0; bit < 8; bit++ {
+			if diff&(1<<bit) != 0 {
+				x := page*8 + bit
+				bounds = bounds.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	return bounds
+}
+
+// Region copies the w x h rectangle at (x, y) out of buf into a buffer of its own, packed the same way as a full frame
+// of Geometry{Width: w, Height: h}. Scale doesn't apply; the region is in screen pixels.
+func (g Geometry) Region(x, y, w, h int, buf []byte) []byte {
+	g.Scale = 0
+	region := Geometry{Width: w, Height: h}
+	out := region.Blank()
+	for xx := 0; xx < w; xx++ {
+		for yy := 0; yy < h; yy++ {
+			if g.Pixel(x+xx, y+yy, buf) {
+				out = region.WritePixel(xx, yy, out)
+			}
+		}
+	}
+	return out
+}
+
+// WriteRegion copies data, packed as Region returns it, back into the w x h rectangle at (x, y) of buf.
+func (g Geometry) WriteRegion(x, y, w, h int, data, buf []byte) []byte {
+	g.Scale = 0
+	region := Geometry{Width: w, Height: h}
+	for xx := 0; xx < w; xx++ {
+		for yy := 0; yy < h; yy++ {
+			if region.Pixel(xx, yy, data) {
+				buf = g.WritePixel(x+xx, y+yy, buf)
+			} else {
+				buf = g.ClearPixel(x+xx, y+yy, buf)
+			}
+		}
+	}
+	return buf
+}
+
+// WriteUpscaled draws data, a srcW x srcH frame packed as for Geometry{Width: srcW, Height: srcH}, with its bottom left
+// corner at (x, y) and each of its pixels a factor x factor block. Off pixels are cleared.
+func (g Geometry) WriteUpscaled(x, y, srcW, srcH, factor int, data, buf []byte) []byte {
+	src := Geometry{Width: srcW, Height: srcH}
+	for sx := 0; sx < srcW; sx++ {
+		for sy := 0; sy < srcH; sy++ {
+			buf = g.setRect(x+sx*factor, y+sy*factor, factor, factor, src.Pixel(sx, sy, data), buf)
+		}
+	}
+	return buf
+}
+
+// WriteLine writes a line.  Bresenham's algorithm
+func (g Geometry) WriteLine(x0, y0, x1, y1 int, buf []byte) []byte {
+	steep := math.Abs(float64(y1-y0)) > math.Abs(float64(x1-x0))
+	if steep {
+		x0, y0 = y0, x0
+		x1, y1 = y1, x1
+	}
+
+	if x0 > x1 {
+		x0, x1 = x1, x0
+		y0, y1 = y1, y0
+	}
+
+	dx := x1 - x0
+	dy := y1 - y0
+	if dy < 0 {
+		dy *= -1
+	}
+
+	err := dx / 2
+	ystep := -1
+
+	if y0 < y1 {
+		ystep = 1
+	}
+
+	for x0 <= x1 {
+		if steep {
+			buf = g.WritePixel(y0, x0, buf)
+		} else {
+			buf = g.WritePixel(x0, y0, buf)
+		}
+		err -= dy
+		if err < 0 {
+			y0 += ystep
+			err += dx
+		}
+		x0++
+	}
+	return buf
+}
+
+// WriteRect outlines the w x h rectangle whose bottom left corner is (x, y). A zero width or height draws a single
+// line, and both zero a single pixel.
+func (g Geometry) WriteRect(x, y, w, h int, buf []byte) []byte {
+	right, top := x+w-1, y+h-1
+	if w == 0 {
+		right = x
+	}
+	if h == 0 {
+		top = y
+	}
+	buf = g.WriteLine(x, y, right, y, buf)
+	buf = g.WriteLine(x, top, right, top, buf)
+	buf = g.WriteLine(x, y, x, top, buf)
+	return g.WriteLine(right, y, right, top, buf)
+}
+
+// WriteFillRect fills the w x h rectangle whose bottom left corner is (x, y).
+func (g Geometry) WriteFillRect(x, y, w, h int, buf []byte) []byte {
+	if h < 1 {
+		return buf
+	}
+	for i := x; i < x+w; i++ {
+		buf = g.WriteLine(i, y, i, y+h-1, buf)
+	}
+	return buf
+}
+
+// WriteGrid writes a grid of cols x rows cells covering the w x h region at (x, y). The outermost lines always land
+// on the region's edges, so zero cols or rows just draws the border on that axis.
+func (g Geometry) WriteGrid(x, y, w, h, cols, rows int, buf []byte) []byte {
+	if cols < 1 {
+		cols = 1
+	}
+	if rows < 1 {
+		rows = 1
+	}
+	for i := 0; i <= cols; i++ {
+		xi := x + i*(w-1)/cols
+		buf = g.WriteLine(xi, y, xi, y+h-1, buf)
+	}
+	for j := 0; j <= rows; j++ {
+		yj := y + j*(h-1)/rows
+		buf = g.WriteLine(x, yj, x+w-1, yj, buf)
+	}
+	return buf
+}
+
+// WriteStringKnockout fills the bar and then clears the pixels of text that fall inside it, so the text shows through
+// in the background color. Glyph pixels outside the bar are not drawn at all.
+func (g Geometry) WriteStringKnockout(barX, barY, barW, barH, textX, textY int, text string, buf []byte) []byte {
+	bar := g.WriteFillRect(barX, barY, barW, barH, g.Blank())
+	glyphs := g.WriteString(textX, textY, text, g.Blank())
+	for i := range buf {
+		buf[i] = (buf[i] | bar[i]) &^ (bar[i] & glyphs[i])
+	}
+	return buf
+}
+
+// WriteString writes text in the geometry's font starting at (x, y).
+func (g Geometry) WriteString(x, y int, char string, buf []byte) []byte {
+	g.font().forEachPixel(char, func(dx, dy int) {
+		buf = g.WritePixel(x+dx, y+dy, buf)
+	})
+	return buf
+}
+
+// WriteStringScaled writes text like WriteString, factor times the size, each pixel of the font becoming a factor x
+// factor block. The text grows away from (x, y), so the baseline stays at y.
+func (g Geometry) WriteStringScaled(x, y, factor int, text string, buf []byte) []byte {
+	g.font().forEachPixel(text, func(dx, dy int) {
+		buf = g.setRect(x+dx*factor, y+dy*factor, factor, factor, true, buf)
+	})
+	return buf
+}
+
+// WriteStringSmooth writes text like WriteStringScaled, but smooths the steps of diagonal strokes instead of copying
+// every pixel into a block. The font is supersampled: scaled to twice the size asked for with the Scale2x algorithm,
+// which fills in the corner between two pixels that touch diagonally, then brought back down to factor times the size,
+// a pixel on where at least half of the 2x2 block it covers is.
+func (g Geometry) WriteStringSmooth(x, y, factor int, text string, buf []byte) []byte {
+	lit := map[image.Point]bool{}
+	var bounds image.Rectangle
+	g.font().forEachPixel(text, func(dx, dy int) {
+		lit[image.Point{dx, dy}] = true
+		bounds = bounds.Union(image.Rect(dx, dy, dx+1, dy+1))
+	})
+	on := func(px, py int) bool { return lit[image.Point{px, py}] }
+	// doubled reports whether the pixel at (sx, sy) of the text scaled to twice its size by Scale2x is on. Each quarter
+	// of a font pixel takes the value of the neighbours on its two outer sides when they agree, unless the pixel is
+	// part of a straight edge or a line one pixel wide.
+	doubled := func(sx, sy int) bool {
+		px, py := floorDiv(sx, 2), floorDiv(sy, 2)
+		up, down, left, right := on(px, py+1), on(px, py-1), on(px-1, py), on(px+1, py)
+		if up == down || left == right {
+			return on(px, py)
+		}
+		side, vertical := left, down
+		if sx-2*px == 1 {
+			side = right
+		}
+		if sy-2*py == 1 {
+			vertical = up
+		}
+		if side == vertical {
+			return side
+		}
+		return on(px, py)
+	}
+	for tx := bounds.Min.X * factor; tx < bounds.Max.X*factor; tx++ {
+		for ty := bounds.Min.Y * factor; ty < bounds.Max.Y*factor; ty++ {
+			n := 0
+			for _, u := range []int{2 * tx, 2*tx + 1} {
+				for _, v := range []int{2 * ty, 2*ty + 1} {
+					if doubled(floorDiv(u, factor), floorDiv(v, factor)) {
+						n++
+					}
+				}
+			}
+			if n >= 2 {
+				buf = g.setRect(x+tx, y+ty, 1, 1, true, buf)
+			}
+		}
+	}
+	return buf
+}
+
+// floorDiv divides a by the positive b, rounding down rather than towards zero.
+func floorDiv(a, b int) int {
+	if a < 0 {
+		return -((-a + b - 1) / b)
+	}
+	return a / b
+}
+
+// RenderStringSprite renders text once into a buffer just big enough to hold it, packed as for Geometry{Width: w,
+// Height: h}. The sprite runs from the leftmost to the rightmost lit column of the text, and from the bottom of the
+// font's descenders to the top of its tallest glyph, so the baseline is the font's descent up from the bottom. Text
+// with nothing to draw returns a nil sprite. Blitting windows of it with WriteSprite is cheaper than drawing the glyphs
+// again for every frame of an animation, and keeps each frame an exact shift of the last.
+func (g Geometry) RenderStringSprite(text string) ([]byte, int, int) {
+	font := g.font()
+	left, right := math.MaxInt, math.MinInt
+	font.forEachPixel(text, func(dx, dy int) {
+		if dx < left {
+			left = dx
+		}
+		if dx > right {
+			right = dx
+		}
+	})
+	if right < left {
+		return nil, 0, 0
+	}
+	sprite := Geometry{Width: right - left + 1, Height: font.ascent() + font.descent() + 1, Font: font}
+	return sprite.WriteString(-left, font.descent(), text, sprite.Blank()), sprite.Width, sprite.Height
+}
+
+// WriteSprite turns on the pixels that are on in sprite, a w x h buffer like RenderStringSprite returns, with its
+// bottom left corner at (x, y). Unlike the other helpers it clips rather than wraps, so a sprite can slide on and off
+// the edges.
+func (g Geometry) WriteSprite(x, y, w, h int, sprite, buf []byte) []byte {
+	src := Geometry{Width: w, Height: h}
+	for sx := 0; sx < w; sx++ {
+		for sy := 0; sy < h; sy++ {
+			if !src.Pixel(sx, sy, sprite) {
+				continue
+			}
+			if px, py := x+sx, y+sy; px >= 0 && py >= 0 && px < g.Width && py < g.Height {
+				buf = g.WritePixel(px, py, buf)
+			}
+		}
+	}
+	return buf
+}
+
+// WriteStringRotated writes text like WriteString, rotated counterclockwise by angleDeg degrees about (x, y). Each
+// screen pixel is mapped back into the unrotated text and takes the nearest source pixel, so there are no holes at
+// odd angles.
+func (g Geometry) WriteStringRotated(x, y, angleDeg int, text string, buf []byte) []byte {
+	type point struct{ x, y int }
+	lit := map[point]bool{}
+	g.font().forEachPixel(text, func(dx, dy int) {
+		lit[point{dx, dy}] = true
+	})
+	if len(lit) == 0 {
+		return buf
+	}
+
+	rad := float64(angleDeg) * math.Pi / 180
+	sin, cos := math.Sincos(rad)
+	rotate := func(px, py float64) (float64, float64) {
+		return px*cos - py*sin, px*sin + py*cos
+	}
+
+	// Find where the rotated text lands, then walk that box and look each pixel up in the unrotated text.
+	minX, minY, maxX, maxY := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for p := range lit {
+		rx, ry := rotate(float64(p.x), float64(p.y))
+		minX, maxX = math.Min(minX, rx), math.Max(maxX, rx)
+		minY, maxY = math.Min(minY, ry), math.Max(maxY, ry)
+	}
+	for ry := int(math.Floor(minY)); ry <= int(math.Ceil(maxY)); ry++ {
+		for rx := int(math.Floor(minX)); rx <= int(math.Ceil(maxX)); rx++ {
+			// rotating by -angle takes a screen offset back into the unrotated text
+			sx := float64(rx)*cos + float64(ry)*sin
+			sy := -float64(rx)*sin + float64(ry)*cos
+			if lit[point{int(math.Round(sx)), int(math.Round(sy))}] {
+				buf = g.WritePixel(x+rx, y+ry, buf)
+			}
+		}
+	}
+	return buf
+}
+
+// WriteStringVertical writes text turned a quarter turn, reading bottom to top if up is set and top to bottom
+// otherwise, with its baseline starting at (x, y). Unlike WriteStringRotated every pixel maps exactly, so the glyphs
+// stay as crisp as they are upright.
+func (g Geometry) WriteStringVertical(x, y int, text string, up bool, buf []byte) []byte {
+	g.font().forEachPixel(text, func(dx, dy int) {
+		if up {
+			buf = g.WritePixel(x-dy, y+dx, buf)
+		} else {
+			buf = g.WritePixel(x+dy, y-dx, buf)
+		}
+	})
+	return buf
+}
+
+// WriteStringAlongArc writes text clockwise around the circle of the given radius about (cx, cy), with each glyph
+// standing on the circle and its top facing outward, like the labels on a dial. The first glyph is centered at
+// startDeg, counterclockwise from the positive x axis, and each following glyph is its advance further along the arc.
+func (g Geometry) WriteStringAlongArc(cx, cy, radius, startDeg int, text string, buf []byte) []byte {
+	r := float64(radius)
+	theta := float64(startDeg) * math.Pi / 180
+	for i, cb := range []byte(text) {
+		ch := string(cb)
+		adv := float64(g.textWidth(ch))
+		if i > 0 {
+			// half of the previous glyph and half of this one
+			theta -= (float64(g.textWidth(text[i-1:i])) + adv) / 2 / r
+		}
+		// Glyphs are rotated about their start point, so step back half an advance along the tangent from where
+		// the glyph's center touches the circle.
+		sin, cos := math.Sincos(theta)
+		x := float64(cx) + r*cos - adv/2*sin
+		y := float64(cy) + r*sin + adv/2*cos
+		angleDeg := int(math.Round(theta*180/math.Pi)) - 90
+		buf = g.WriteStringRotated(int(math.Round(x)), int(math.Round(y)), angleDeg, ch, buf)
+	}
+	return buf
+}
+
+// font returns the font text is written in.
+func (g Geometry) font() *Font {
+	if g.Font == nil {
+		return freeMonoBold18
+	}
+	return g.Font
+}
+
+// textWidth returns how far WriteString advances x when writing text.
+func (g Geometry) textWidth(text string) int {
+	return g.font().width(text)
+}
+
+// WriteCalibration sets single pixels at the four corners, the middle of each edge and the center, and labels the
+// bottom left and top right corners with their coordinates. It's for checking the coordinate system, rotation and
+// clipping are what you expect.
+func (g Geometry) WriteCalibration(buf []byte) []byte {
+	right, top := g.Width-1, g.Height-1
+	midX, midY := g.Width/2, g.Height/2
+	for _, p := range [][2]int{
+		{0, 0}, {right, 0}, {0, top}, {right, top},
+		{midX, 0}, {midX, top}, {0, midY}, {right, midY},
+		{midX, midY},
+	} {
+		buf = g.WritePixel(p[0], p[1], buf)
+	}
+
+	// Keep the labels a pixel clear of the edge markers, whatever the font: the bottom one's descenders stop at row 1
+	// and the top one's tallest glyphs at the row below the top.
+	font := g.font()
+	buf = g.WriteString(2, 1+font.descent(), "0,0", buf)
+	label := fmt.Sprintf("%d,%d", right, top)
+	return g.WriteString(g.Width-g.textWidth(label)-2, top-1-font.ascent(), label, buf)
+}
+
+// WriteWaterfall scrolls the w x h region at (x, y) one pixel to the left and draws column as its new rightmost
+// column, bottom to top. Values above 0.5 light their pixel. If column doesn't have h values it is stretched or
+// squashed to fit. Calling this repeatedly builds up a scrolling spectrogram.
+func (g Geometry) WriteWaterfall(x, y, w, h int, column []float64, buf []byte) []byte {
+	for xx := x; xx < x+w-1; xx++ {
+		for yy := y; yy < y+h; yy++ {
+			if g.Pixel(xx+1, yy, buf) {
+				buf = g.WritePixel(xx, yy, buf)
+			} else {
+				buf = g.ClearPixel(xx, yy, buf)
+			}
+		}
+	}
+	right := x + w - 1
+	for row := 0; row < h; row++ {
+		if len(column) > 0 && column[row*len(column)/h] > 0.5 {
+			buf = g.WritePixel(right, y+row, buf)
+		} else {
+			buf = g.ClearPixel(right, y+row, buf)
+		}
+	}
+	return buf
+}
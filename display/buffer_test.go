@@ -0,0 +1,337 @@
+package display
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"go.viam.com/test"
+)
+
+// litOnly checks that inside the w x h region at (x, y) of buf, exactly the pixels lit says are on.
+func litOnly(t *testing.T, g Geometry, buf []byte, x, y, w, h int, lit func(px, py int) bool) {
+	t.Helper()
+	for py := y; py < y+h; py++ {
+		for px := x; px < x+w; px++ {
+			if g.Pixel(px, py, buf) != lit(px, py) {
+				t.Fatalf("pixel (%d, %d) is %v, want %v", px, py, g.Pixel(px, py, buf), lit(px, py))
+			}
+		}
+	}
+}
+
+func TestWriteGrid(t *testing.T) {
+	g := defaultGeometry
+
+	// a 4x2 grid 41x21 pixels at (3, 5) has cells 10 pixels apart, the last lines on the region's far edges
+	buf := g.WriteGrid(3, 5, 41, 21, 4, 2, g.Blank())
+	columns := map[int]bool{3: true, 13: true, 23: true, 33: true, 43: true}
+	rows := map[int]bool{5: true, 15: true, 25: true}
+	litOnly(t, g, buf, 3, 5, 41, 21, func(px, py int) bool { return columns[px] || rows[py] })
+	// nothing outside it
+	test.That(t, g.Pixel(44, 5, buf), test.ShouldBeFalse)
+	test.That(t, g.Pixel(3, 26, buf), test.ShouldBeFalse)
+
+	// no columns or rows is just the border
+	buf = g.WriteGrid(0, 0, 10, 6, 0, 0, g.Blank())
+	litOnly(t, g, buf, 0, 0, 10, 6, func(px, py int) bool { return px == 0 || px == 9 || py == 0 || py == 5 })
+}
+
+func TestWriteStringPlacement(t *testing.T) {
+	g := defaultGeometry
+	// y is the baseline and counts up the screen, so the A stands on row 30 and the g's descender hangs below it
+	buf := g.WriteString(2, 30, "Ag", g.Blank())
+
+	// the A's feet are on the baseline with nothing under them, and its apex is 20 rows up, narrower than the feet
+	test.That(t, g.Pixel(2, 30, buf), test.ShouldBeTrue)
+	test.That(t, g.Pixel(21, 30, buf), test.ShouldBeTrue)
+	litOnly(t, g, buf, 0, 29, 23, 1, func(px, py int) bool { return false })
+	litOnly(t, g, buf, 0, 50, 23, 1, func(px, py int) bool { return px >= 5 && px <= 13 })
+	litOnly(t, g, buf, 0, 51, 23, 1, func(px, py int) bool { return false })
+	// the crossbar, with the gap between the legs above it
+	test.That(t, g.Pixel(10, 37, buf), test.ShouldBeTrue)
+	test.That(t, g.Pixel(10, 41, buf), test.ShouldBeFalse)
+
+	// the g starts one advance over, its top 15 rows up and its descender 7 rows down
+	test.That(t, g.Pixel(29, 45, buf), test.ShouldBeTrue)
+	litOnly(t, g, buf, 23, 46, 23, 1, func(px, py int) bool { return false })
+	test.That(t, g.Pixel(30, 23, buf), test.ShouldBeTrue)
+	litOnly(t, g, buf, 23, 22, 23, 1, func(px, py int) bool { return false })
+}
+
+func TestWritePixelLayout(t *testing.T) {
+	g := defaultGeometry
+	// x runs 0 to 127 across the screen and y 0 to 63 up it. Each byte holds 8 pixels side by side, so x picks the bit
+	// and the column of bytes, y the byte within that column.
+	for _, tc := range []struct {
+		x, y  int
+		index int
+		bit   byte
+	}{
+		{0, 0, 0, 0x01},
+		{3, 5, 5, 0x08},
+		{8, 0, 64, 0x01},
+		{100, 10, 10 + 12*64, 0x10},
+		{127, 63, 63 + 15*64, 0x80},
+	} {
+		buf := g.WritePixel(tc.x, tc.y, g.Blank())
+		want := g.Blank()
+		want[tc.index] = tc.bit
+		test.That(t, buf, test.ShouldResemble, want)
+		test.That(t, g.Pixel(tc.x, tc.y, buf), test.ShouldBeTrue)
+	}
+
+	// a line's endpoints land where they're given, whichever axis it runs along
+	buf := g.WriteLine(0, 10, 127, 10, g.Blank())
+	litOnly(t, g, buf, 0, 0, 128, 64, func(px, py int) bool { return py == 10 })
+	buf = g.WriteLine(20, 0, 20, 63, g.Blank())
+	litOnly(t, g, buf, 0, 0, 128, 64, func(px, py int) bool { return px == 20 })
+	buf = g.WriteLine(2, 1, 9, 8, g.Blank())
+	litOnly(t, g, buf, 0, 0, 128, 64, func(px, py int) bool { return px-py == 1 && px >= 2 && px <= 9 })
+}
+
+func TestOffscreenBuffer(t *testing.T) {
+	// a 128x32 buffer has 16 columns of 32 bytes rather than of 64
+	g := Geometry{Width: 128, Height: 32}
+	buf := NewBuffer(128, 32)
+	test.That(t, buf, test.ShouldHaveLength, 512)
+	for _, tc := range []struct {
+		x, y  int
+		index int
+		bit   byte
+	}{
+		{0, 0, 0, 0x01},
+		{3, 5, 5, 0x08},
+		{8, 0, 32, 0x01},
+		{100, 10, 10 + 12*32, 0x10},
+		{127, 31, 31 + 15*32, 0x80},
+	} {
+		buf := g.WritePixel(tc.x, tc.y, NewBuffer(128, 32))
+		want := NewBuffer(128, 32)
+		want[tc.index] = tc.bit
+		test.That(t, buf, test.ShouldResemble, want)
+	}
+
+	// shapes stop at the buffer's own top row rather than a 64 row panel's
+	buf = g.WriteLine(5, 0, 5, 31, buf)
+	litOnly(t, g, buf, 0, 0, 128, 32, func(px, py int) bool { return px == 5 })
+	buf = g.WriteRect(20, 2, 10, 30, NewBuffer(128, 32))
+	litOnly(t, g, buf, 0, 0, 128, 32, func(px, py int) bool {
+		return px >= 20 && px < 30 && (py == 2 || py == 31) || py >= 2 && (px == 20 || px == 29)
+	})
+}
+
+func TestPatternCheckerboard(t *testing.T) {
+	g := Geometry{Width: 128, Height: 64, Scale: 2}
+	buf, err := g.TestPattern("checkerboard")
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, buf, test.ShouldHaveLength, 128*64/8)
+	// each byte is a row of 8 pixels starting at an even x, so even rows light bits 0, 2, 4 and 6 and odd rows the rest
+	for i, b := range buf {
+		want := byte(0x55)
+		if i%g.Height%2 == 1 {
+			want = 0xAA
+		}
+		test.That(t, b, test.ShouldEqual, want)
+	}
+
+	_, err = g.TestPattern("plaid")
+	test.That(t, err, test.ShouldBeError)
+}
+
+func TestWriteStringSmooth(t *testing.T) {
+	g := defaultGeometry
+	naive := g.WriteStringScaled(2, 10, 2, "/", g.Blank())
+	smooth := g.WriteStringSmooth(2, 10, 2, "/", g.Blank())
+	test.That(t, smooth, test.ShouldNotResemble, naive)
+
+	// the diagonal's steps move by at most a pixel, they don't change the stroke's shape or weight
+	near := func(buf []byte, x, y int) bool {
+		for dx := -1; dx <= 1; dx++ {
+			for dy := -1; dy <= 1; dy++ {
+				if g.Pixel(x+dx, y+dy, buf) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	changed, lit := 0, 0
+	for x := 0; x < g.Width; x++ {
+		for y := 0; y < g.Height; y++ {
+			if g.Pixel(x, y, smooth) != g.Pixel(x, y, naive) {
+				changed++
+				test.That(t, near(naive, x, y) && near(smooth, x, y), test.ShouldBeTrue)
+			}
+			if g.Pixel(x, y, smooth) {
+				lit++
+			}
+		}
+	}
+	test.That(t, changed, test.ShouldBeGreaterThan, 0)
+	test.That(t, lit, test.ShouldBeGreaterThan, changed*4)
+}
+
+func TestWriteCalibration(t *testing.T) {
+	// a 3x5 font with a comma that hangs one pixel below the baseline, far smaller than the default
+	block := []int{0, 3, 5, 4, 0, -4}
+	small := &Font{Bitmap: []byte{0xFF, 0xFF}, First: ',', Glyphs: [][]int{{0, 1, 2, 2, 0, 0}}}
+	for c := byte('-'); c <= '9'; c++ {
+		small.Glyphs = append(small.Glyphs, block)
+	}
+
+	for _, font := range []*Font{nil, small} {
+		g := defaultGeometry
+		g.Font = font
+		buf := g.WriteCalibration(g.Blank())
+		right, top := g.Width-1, g.Height-1
+		for _, p := range [][2]int{{0, 0}, {right, 0}, {0, top}, {right, top}, {g.Width / 2, g.Height / 2}} {
+			test.That(t, g.Pixel(p[0], p[1], buf), test.ShouldBeTrue)
+		}
+		// the edges hold only the markers
+		columns := map[int]bool{0: true, g.Width / 2: true, right: true}
+		rows := map[int]bool{0: true, g.Height / 2: true, top: true}
+		litOnly(t, g, buf, 0, 0, g.Width, 1, func(px, py int) bool { return columns[px] })
+		litOnly(t, g, buf, 0, top, g.Width, 1, func(px, py int) bool { return columns[px] })
+		litOnly(t, g, buf, 0, 0, 1, g.Height, func(px, py int) bool { return rows[py] })
+		litOnly(t, g, buf, right, 0, 1, g.Height, func(px, py int) bool { return rows[py] })
+		// and the labels are in the bottom left and top right corners
+		bottomLeft := g.Region(1, 1, g.Width/2-1, g.Height/2-1, buf)
+		topRight := g.Region(g.Width/2+1, g.Height/2+1, g.Width/2-2, g.Height/2-2, buf)
+		test.That(t, bottomLeft, test.ShouldNotResemble, make([]byte, len(bottomLeft)))
+		test.That(t, topRight, test.ShouldNotResemble, make([]byte, len(topRight)))
+	}
+
+	// where the labels' characters are the font's tallest and deepest, they come to within a pixel of the edges
+	g := defaultGeometry
+	g.Font = small
+	buf := g.WriteCalibration(g.Blank())
+	bottomRow := g.Region(0, 1, g.Width, 1, buf)
+	topRow := g.Region(0, g.Height-2, g.Width, 1, buf)
+	test.That(t, bottomRow, test.ShouldNotResemble, make([]byte, len(bottomRow)))
+	test.That(t, topRow, test.ShouldNotResemble, make([]byte, len(topRow)))
+}
+
+func TestWriteWaterfall(t *testing.T) {
+	g := defaultGeometry
+	// pixels either side of the 8x4 region at (10, 10), which the waterfall must leave alone
+	buf := g.WritePixel(9, 11, g.Blank())
+	buf = g.WritePixel(18, 12, buf)
+
+	buf = g.WriteWaterfall(10, 10, 8, 4, []float64{1, 0, 0, 0.9}, buf)
+	litOnly(t, g, buf, 10, 10, 8, 4, func(px, py int) bool { return px == 17 && (py == 10 || py == 13) })
+
+	// the first column moves a pixel left as the second comes in on the right
+	buf = g.WriteWaterfall(10, 10, 8, 4, []float64{0, 0.6, 0.7, 0.5}, buf)
+	litOnly(t, g, buf, 10, 10, 8, 4, func(px, py int) bool {
+		return px == 16 && (py == 10 || py == 13) || px == 17 && (py == 11 || py == 12)
+	})
+
+	// and again, with a two value column stretched over the four rows
+	buf = g.WriteWaterfall(10, 10, 8, 4, []float64{1, 0}, buf)
+	litOnly(t, g, buf, 10, 10, 8, 4, func(px, py int) bool {
+		return px == 15 && (py == 10 || py == 13) || px == 16 && (py == 11 || py == 12) || px == 17 && py < 12
+	})
+	test.That(t, g.Pixel(9, 11, buf), test.ShouldBeTrue)
+	test.That(t, g.Pixel(18, 12, buf), test.ShouldBeTrue)
+}
+
+func TestWriteUpscaled(t *testing.T) {
+	g := defaultGeometry
+	// a 2x2 source with its bottom left and top right pixels on
+	src := Geometry{Width: 2, Height: 2}
+	data := src.WritePixel(0, 0, src.Blank())
+	data = src.WritePixel(1, 1, data)
+
+	// every pixel of the 4x4 block starts lit, so the off source pixels have to clear theirs
+	buf := g.setRect(30, 20, 4, 4, true, g.Blank())
+	buf = g.WriteUpscaled(30, 20, 2, 2, 2, data, buf)
+	litOnly(t, g, buf, 28, 18, 8, 8, func(px, py int) bool {
+		return px >= 30 && px < 32 && py >= 20 && py < 22 || px >= 32 && px < 34 && py >= 22 && py < 24
+	})
+
+	data = src.WritePixel(1, 0, data)
+	data = src.WritePixel(0, 1, data)
+	buf = g.WriteUpscaled(30, 20, 2, 2, 2, data, g.Blank())
+	litOnly(t, g, buf, 28, 18, 8, 8, func(px, py int) bool { return px >= 30 && px < 34 && py >= 20 && py < 24 })
+}
+
+func TestWriteStringRotatedQuarterTurns(t *testing.T) {
+	g := defaultGeometry
+	// a quarter turn maps every pixel exactly, so it's the same as writing the text vertically
+	rotated := g.WriteStringRotated(60, 10, 90, "Hey", g.Blank())
+	test.That(t, rotated, test.ShouldNotResemble, g.Blank())
+	test.That(t, rotated, test.ShouldResemble, g.WriteStringVertical(60, 10, "Hey", true, g.Blank()))
+	test.That(t, g.WriteStringRotated(60, 100, -90, "Hey", g.Blank()), test.ShouldResemble,
+		g.WriteStringVertical(60, 100, "Hey", false, g.Blank()))
+	test.That(t, g.WriteStringRotated(10, 60, 0, "Hey", g.Blank()), test.ShouldResemble,
+		g.WriteString(10, 60, "Hey", g.Blank()))
+}
+
+func TestWriteStringAlongArc(t *testing.T) {
+	g := defaultGeometry
+	// the default font advances 21 pixels a glyph, which around a radius of 40 is about 30 degrees
+	test.That(t, g.textWidth("A"), test.ShouldEqual, 21)
+	cx, cy, r := 64, 5, 40
+
+	// the first glyph, centered at the top of the circle (its start half an advance left, rounded), stands upright
+	first := g.WriteStringAlongArc(cx, cy, r, 90, "A", g.Blank())
+	test.That(t, first, test.ShouldResemble, g.WriteString(cx-10, cy+r, "A", g.Blank()))
+
+	// the last is 30 degrees clockwise from it, and leans 30 degrees to match, its start half an advance back along
+	// the tangent from where its center touches the circle
+	theta := math.Pi/2 - 21.0/40
+	sin, cos := math.Sincos(theta)
+	x := int(math.Round(float64(cx) + float64(r)*cos - 10.5*sin))
+	y := int(math.Round(float64(cy) + float64(r)*sin + 10.5*cos))
+	want := g.WriteStringRotated(x, y, -30, "V", first)
+	test.That(t, g.WriteStringAlongArc(cx, cy, r, 90, "AV", g.Blank()), test.ShouldResemble, want)
+}
+
+func TestWriteImageRegion(t *testing.T) {
+	g := defaultGeometry
+	// a white 64x64 image whose bottom right quadrant is black but for its top left and bottom right pixels
+	img := image.NewGray(image.Rect(0, 0, 64, 64))
+	for x := 0; x < 64; x++ {
+		for y := 0; y < 64; y++ {
+			if x < 32 || y < 32 {
+				img.SetGray(x, y, color.Gray{Y: 255})
+			}
+		}
+	}
+	img.SetGray(32, 32, color.Gray{Y: 255})
+	img.SetGray(63, 63, color.Gray{Y: 255})
+
+	// the quadrant lands upright with its bottom left corner at (10, 5), so its top row is the screen's row 36
+	buf := g.WriteImageRegion(10, 5, img, 32, 32, 32, 32, g.Blank())
+	litOnly(t, g, buf, 0, 0, g.Width, g.Height, func(px, py int) bool {
+		return px == 10 && py == 36 || px == 41 && py == 5
+	})
+}
+
+func TestWriteStringKnockout(t *testing.T) {
+	g := defaultGeometry
+	inBar := func(px, py int) bool { return px < 30 && py >= 15 && py < 45 }
+	glyphs := g.WriteString(4, 20, "OK", g.Blank())
+	// the text overhangs the bar's right end, so some of its pixels are knocked out and some aren't drawn at all
+	inside, outside := 0, 0
+	for px := 0; px < g.Width; px++ {
+		for py := 0; py < g.Height; py++ {
+			switch {
+			case !g.Pixel(px, py, glyphs):
+			case inBar(px, py):
+				inside++
+			default:
+				outside++
+			}
+		}
+	}
+	test.That(t, inside, test.ShouldBeGreaterThan, 0)
+	test.That(t, outside, test.ShouldBeGreaterThan, 0)
+
+	buf := g.WriteStringKnockout(0, 15, 30, 30, 4, 20, "OK", g.Blank())
+	litOnly(t, g, buf, 0, 0, g.Width, g.Height, func(px, py int) bool {
+		return inBar(px, py) && !g.Pixel(px, py, glyphs)
+	})
+}
@@ -0,0 +1,83 @@
+package display
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// lineHeight is how far apart the baselines of consecutive lines of text are, enough that descenders don't touch the
+// line below.
+func (g Geometry) lineHeight() int {
+	return g.font().ascent() + g.font().descent() + 2
+}
+
+// wrapLines splits text into lines no wider than maxWidth, breaking between words where it can and inside a word too
+// long to fit on a line of its own. Runs of spaces collapse to one.
+func (g Geometry) wrapLines(text string, maxWidth int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if g.textWidth(candidate) <= maxWidth {
+			line = candidate
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+		// a word that doesn't fit a line of its own is split wherever the line is full
+		for g.textWidth(word) > maxWidth {
+			n := 1
+			for n < len(word) && g.textWidth(word[:n+1]) <= maxWidth {
+				n++
+			}
+			lines = append(lines, word[:n])
+			word = word[n:]
+		}
+		line = word
+	}
+	if line != "" || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// WriteStringWrapped writes text word wrapped to lines no wider than maxWidth, the first on the baseline y and the
+// rest below it, and returns how many lines it took.
+func (g Geometry) WriteStringWrapped(x, y int, text string, maxWidth int, buf []byte) ([]byte, int) {
+	lines := g.wrapLines(text, maxWidth)
+	for i, line := range lines {
+		buf = g.WriteString(x, y-i*g.lineHeight(), line, buf)
+	}
+	return buf, len(lines)
+}
+
+// LogLine adds text to the bottom of the on-screen log, word wrapped to the screen width and prefixed with the time
+// if withTimestamp is set, and scrolls older lines up and off the top. The log takes over the whole screen; anything
+// else drawn since the last LogLine is replaced.
+func (d *display) LogLine(ctx context.Context, text string, withTimestamp bool) error {
+	if withTimestamp {
+		text = time.Now().Format("15:04:05") + " " + text
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	rows := d.geom.Height / d.geom.lineHeight()
+	if rows < 1 {
+		rows = 1
+	}
+	d.console = append(d.console, d.geom.wrapLines(text, d.geom.Width)...)
+	if len(d.console) > rows {
+		d.console = d.console[len(d.console)-rows:]
+	}
+
+	buf := d.geom.Blank()
+	top := d.geom.Height - 1 - d.geom.font().ascent()
+	for i, line := range d.console {
+		buf = d.geom.WriteString(0, top-i*d.geom.lineHeight(), line, buf)
+	}
+	return d.flush(ctx, buf)
+}
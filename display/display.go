@@ -3,7 +3,11 @@ package display
 import (
 	"context"
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
 	"math"
+	"sync"
 	"time"
 
 	"github.com/biotinker/viam-i2c-display/display/api/displayapi"
@@ -18,56 +22,437 @@ import (
 	 * 	sh110xBLACK                   = 0    ///< Draw 'off' pixels
 		sh110xWHITE                   = 1    ///< Draw 'on' pixels
 		sh110xINVERSE                 = 2    ///< Invert pixels
-		sh110xCOLUMNADDR         byte = 0x21 ///< See datasheet
-		sh110xPAGEADDR           byte = 0x22 ///< See datasheet
-		sh110xCHARGEPUMP         byte = 0x8D ///< See datasheet
 		sh110xDISPLAYALLON       byte = 0xA5 ///< Not currently used
-		sh110xINVERTDISPLAY      byte = 0xA7 ///< See datasheet
-		sh110xDISPLAYON          byte = 0xAF ///< See datasheet
-		sh110xSETPAGEADDR        byte = 0xB0 ///< Specify page address to load display RAM data to page address
-		sh110xCOMSCANDEC         byte = 0xC8 ///< See datasheet
-		sh110xSETCOMPINS         byte = 0xDA ///< See datasheet
-		sh110xSETLOWCOLUMN       byte = 0x00 ///< Not currently used
-		sh110xSETHIGHCOLUMN      byte = 0x10 ///< Not currently used
-		sh110xSETSTARTLINE       byte = 0x40 ///< See datasheet
 */
 const (
+	sh110xSETLOWCOLUMN       byte = 0x00 ///< Low nibble of the column address
+	sh110xSETHIGHCOLUMN      byte = 0x10 ///< High nibble of the column address
 	sh110xMEMORYMODE         byte = 0x20 ///< See datasheet
+	sh110xCOLUMNADDR         byte = 0x21 ///< See datasheet
+	sh110xPAGEADDR           byte = 0x22 ///< See datasheet
+	sh110xSETSTARTLINE       byte = 0x40 ///< See datasheet
 	sh110xSETCONTRAST        byte = 0x81 ///< See datasheet
+	sh110xCHARGEPUMP         byte = 0x8D ///< See datasheet
 	sh110xSEGREMAP           byte = 0xA0 ///< See datasheet
 	sh110xDISPLAYALLONRESUME byte = 0xA4 ///< See datasheet
 	sh110xNORMALDISPLAY      byte = 0xA6 ///< See datasheet
+	sh110xINVERTDISPLAY      byte = 0xA7 ///< See datasheet
 	sh110xSETMULTIPLEX       byte = 0xA8 ///< See datasheet
 	sh110xDCDC               byte = 0xAD ///< See datasheet
 	sh110xDISPLAYOFF         byte = 0xAE ///< See datasheet
-	sh110xCOMSCANINC         byte = 0xC0 ///< Not currently used
+	sh110xDISPLAYON          byte = 0xAF ///< See datasheet
+	sh110xSETPAGEADDR        byte = 0xB0 ///< Specify page address to load display RAM data to page address
+	sh110xCOMSCANINC         byte = 0xC0 ///< See datasheet
+	sh110xCOMSCANDEC         byte = 0xC8 ///< See datasheet
 	sh110xSETDISPLAYOFFSET   byte = 0xD3 ///< See datasheet
 	sh110xSETDISPLAYCLOCKDIV byte = 0xD5 ///< See datasheet
 	sh110xSETPRECHARGE       byte = 0xD9 ///< See datasheet
+	sh110xSETCOMPINS         byte = 0xDA ///< See datasheet
 	sh110xSETVCOMDETECT      byte = 0xDB ///< See datasheet
 	sh110xSETDISPSTARTLINE   byte = 0xDC ///< Specify Column address to determine the initial display line or < COM0.
 )
 
+// Scrolling commands, which only the SSD1306 has.
+const (
+	ssd1306RIGHTHORIZONTALSCROLL byte = 0x26 ///< Set up a scroll to the right
+	ssd1306LEFTHORIZONTALSCROLL  byte = 0x27 ///< Set up a scroll to the left
+	ssd1306DEACTIVATESCROLL      byte = 0x2E ///< Stop scrolling
+	ssd1306ACTIVATESCROLL        byte = 0x2F ///< Start the scroll set up last
+)
+
 const defaultI2Caddr = 0x3C
 
+// I2C control bytes sent ahead of every transfer to tell the controller whether commands or display data follow.
+// With the Co (continuation) bit clear, one control byte introduces the rest of the transfer; with it set, every
+// following byte is preceded by its own control byte.
+const (
+	ctrlCoBit   byte = 0x80
+	ctrlCommand byte = 0x00
+	ctrlData    byte = 0x40
+)
+
+// How many times the constructor sends the init sequence, failing only if every attempt does, and how long init
+// waits before turning the panel on, unless init_retries and init_delay_ms say otherwise.
+const (
+	defaultInitAttempts = 4
+	defaultInitDelay    = 100 * time.Millisecond
+)
+
+// reinitLogInterval is the minimum time between log lines about the display being reinitialized.
+const reinitLogInterval = 30 * time.Second
+
+// The largest panel the controller can drive: 16 pages of 8 columns, and 128 COM lines.
+const (
+	maxWidth  = 128
+	maxHeight = 128
+)
+
+// maxDataTransfer is the most display data sent in one transfer. With its control byte it fits the 32 byte buffers of
+// common I2C drivers. co framing puts a control byte ahead of every data byte, so it sends half as much,
+// maxDataTransferCo, to fit the same buffer.
+const (
+	maxDataTransfer   = 31
+	maxDataTransferCo = 15
+)
+
+// defaultContrast is the contrast init sets until SetContrast changes it.
+const defaultContrast byte = 0x4F
+
+// antiGhostHold is how long the panel is left inverted during an anti-ghosting cycle.
+const antiGhostHold = 50 * time.Millisecond
+
+// With the "retry" error_policy, how many more times a failed write is tried and how long to wait before each retry.
+const (
+	writeRetries    = 3
+	writeRetryDelay = 5 * time.Millisecond
+)
+
+// How long the self check leaves its test pattern and its result on screen.
+const (
+	selfCheckPatternHold = 500 * time.Millisecond
+	selfCheckResultHold  = 2 * time.Second
+)
+
+// Supported values for the text_anchor attribute, which says what the y passed to the text methods refers to.
+//
+//	     y (top) -> ##..##    .....
+//	                ##..##    .....
+//	                ######    .##..
+//	                ##..##    #..#.
+//	y (baseline) -> ##..##    .###.   <- glyphs sit on this row
+//	                          ...#.   <- descenders hang below it
+//	                          .##..
+//
+// With "baseline" (the default) the row y is the bottom row of letters without descenders. With "top" the row y is
+// the top of the tallest glyph in the font, so the text is drawn the font's ascent lower than for the same y.
+const (
+	anchorBaseline = "baseline"
+	anchorTop      = "top"
+)
+
+// Supported values for the clip_mode attribute, which says what happens to pixels drawn off the screen.
+const (
+	clipModeClip  = "clip"  // drop them (the default)
+	clipModeWrap  = "wrap"  // wrap them around to the opposite edge
+	clipModeError = "error" // fail the whole draw
+)
+
+// Supported values for the clear_pattern attribute, mapped to the test pattern each one fills the screen with.
+var clearPatterns = map[string]string{
+	"off":     "all-off",
+	"on":      "all-on",
+	"checker": "checkerboard",
+}
+
+// Bits of the status byte the controller returns on a read.
+const (
+	statusBusy       byte = 0x80 // still processing a command
+	statusDisplayOff byte = 0x40 // the panel is off, as it is after power up or a reset until init turns it on
+	statusIDMask     byte = 0x3F // controller ID
+)
+
+// Controller IDs found in the low bits of the status byte.
+const (
+	idSSD1306 byte = 0x06
+	idSH1107  byte = 0x07
+	idSH1106  byte = 0x08
+)
+
+var controllerIDs = map[byte]string{
+	idSSD1306: "ssd1306",
+	idSH1107:  "sh1107",
+	idSH1106:  "sh1106",
+}
+
+// Supported values for the com_scan attribute, mapped to the COM output scan direction command sent during init.
+// "dec" scans the rows bottom to top, which flips the image vertically for panels mounted upside down.
+var comScans = map[string]byte{
+	"inc": sh110xCOMSCANINC,
+	"dec": sh110xCOMSCANDEC,
+}
+
+// Supported values for the error_policy attribute, which says what a draw does when a write to the panel fails.
+const (
+	policyBestEffort = "best-effort" // carry on with the rest of the frame, then return the failure
+	policyFailFast   = "fail-fast"   // stop at the first failed write and return its error
+	policyRetry      = "retry"       // retry a failed write writeRetries times, then fail like fail-fast
+)
+
+// Supported values for the page_order attribute. Pages are 8 pixel wide column bands, numbered from the left.
+const (
+	pageOrderForward = "forward" // left to right
+	pageOrderReverse = "reverse" // right to left
+)
+
+// Supported values for the controller attribute.
+const (
+	controllerSH110x  = "sh110x"
+	controllerSSD1306 = "ssd1306"
+)
+
+// Supported values for the control_framing attribute.
+const (
+	framingStream = "stream"
+	framingCo     = "co"
+)
+
 var Model = resource.ModelNamespace("biotinker").WithFamily("component").WithModel("display")
 
 // Config is used for converting config attributes.
 type Config struct {
-	I2CBus        string `json:"i2c_bus"`
+	// Transport is how the panel is wired, "i2c" (the default) or "spi". I2CBus is required for i2c, and Board,
+	// SPIBus, ChipSelect and DCPin for spi.
+	Transport     string `json:"transport,omitempty"`
+	I2CBus        string `json:"i2c_bus,omitempty"`
 	I2cAddr       int    `json:"i2c_addr,omitempty"`
-	SkipAnimation bool   `json:"skip_animation",omitempty"`
+	SkipAnimation bool   `json:"skip_animation,omitempty"`
+	// Controller is the panel's controller chip, "sh110x" (the default) or "ssd1306".
+	Controller string `json:"controller,omitempty"`
+	// ControlFraming is "stream" (the default, 0x00/0x40 control bytes) or "co" (0x80/0xC0, one per byte).
+	ControlFraming string `json:"control_framing,omitempty"`
+	// ColumnOffset and PageOffset are where the panel's visible area starts in the controller's RAM, for panels
+	// smaller than the RAM that aren't wired to its corner. Both are 0 if unset.
+	ColumnOffset int `json:"column_offset,omitempty"`
+	PageOffset   int `json:"page_offset,omitempty"`
+	// Preset sets the controller, size and offsets of a known panel, see presets. Attributes set alongside it win.
+	Preset string `json:"preset,omitempty"`
+	// AllowUnsafeCommands enables DoCommands that send raw bytes to the controller.
+	AllowUnsafeCommands bool `json:"allow_unsafe_commands,omitempty"`
+	// AntiGhostInterval, if set, briefly inverts the whole panel every that many flushes to clear ghosting.
+	AntiGhostInterval int `json:"anti_ghost_interval,omitempty"`
+	// ErrorPolicy is "best-effort" (the default), "fail-fast" or "retry", see policyBestEffort.
+	ErrorPolicy string `json:"error_policy,omitempty"`
+	// PageOrder is the order frames are sent in, "forward" (the default) or "reverse", see pageOrderForward.
+	PageOrder string `json:"page_order,omitempty"`
+	// UndoDepth is how many draws Undo can step back through, defaultUndoDepth if unset.
+	UndoDepth int `json:"undo_depth,omitempty"`
+	// TextAnchor is "baseline" (the default) or "top", see anchorBaseline.
+	TextAnchor string `json:"text_anchor,omitempty"`
+	// SmoothText supersamples text drawn at 2x and up to smooth its diagonal strokes, see WriteStringSmooth.
+	SmoothText bool `json:"smooth_text,omitempty"`
+	// ClipMode is "clip" (the default), "wrap" or "error", see clipModeClip.
+	ClipMode string `json:"clip_mode,omitempty"`
+	// ReportClipped counts the pixels each draw drops off the screen, for the clipped_pixels DoCommand. It's off by
+	// default to keep the count out of the common path.
+	ReportClipped bool `json:"report_clipped,omitempty"`
+	// SkipResetCheck stops draws from checking whether the controller has reset and needs reinitializing.
+	SkipResetCheck bool `json:"skip_reset_check,omitempty"`
+	// SelfCheck probes the bus after init and shows the result on the panel.
+	SelfCheck bool `json:"self_check,omitempty"`
+	// I2CSpeedHz is rejected: the bus speed is set by the kernel (device tree) and buses.I2C can't change it. It's
+	// kept so configs that set it fail with an explanation instead of being silently ignored.
+	I2CSpeedHz int `json:"i2c_speed_hz,omitempty"`
+	// ClearPattern is what Reset and startup leave on the screen: "off" (the default), "on" or "checker".
+	ClearPattern string `json:"clear_pattern,omitempty"`
+	// ComScan is the COM scan direction set during init: "inc" (the default) or "dec".
+	ComScan string `json:"com_scan,omitempty"`
+	// FlipH and FlipV mirror the whole panel in hardware, through the segment remap and COM scan direction set
+	// during init. FlipV undoes a "dec" com_scan.
+	FlipH bool `json:"flip_h,omitempty"`
+	FlipV bool `json:"flip_v,omitempty"`
+	// Width and Height are the panel's size in pixels, defaultGeometry's 128x64 if unset. Width must be a whole
+	// number of 8 pixel pages.
+	Width  int `json:"width,omitempty"`
+	Height int `json:"height,omitempty"`
+	// Rotation turns the image clockwise by 0 (the default), 90, 180 or 270 degrees, for panels mounted sideways or
+	// upside down. At 90 and 270 the screen's width and height swap.
+	Rotation int `json:"rotation,omitempty"`
+	// PadDisplayBytes restores DisplayBytes' old behavior of padding short frames and truncating long ones instead of
+	// rejecting them.
+	PadDisplayBytes bool `json:"pad_display_bytes,omitempty"`
+	// InitRetries is how many times init is attempted at startup, defaultInitAttempts if unset. InitDelayMs is how
+	// long init waits for the controller to settle before turning the panel on, defaultInitDelay if unset.
+	InitRetries int `json:"init_retries,omitempty"`
+	InitDelayMs int `json:"init_delay_ms,omitempty"`
+	// SPI wiring: the board whose GPIO pins the data/command and optional reset lines are on, the SPI bus and chip
+	// select the panel is on, and the clock rate, defaultSPIBaud if unset.
+	Board      string `json:"board,omitempty"`
+	SPIBus     string `json:"spi_bus,omitempty"`
+	ChipSelect string `json:"chip_select,omitempty"`
+	DCPin      string `json:"dc_pin,omitempty"`
+	ResetPin   string `json:"reset_pin,omitempty"`
+	SPIBaudHz  int    `json:"spi_baud_hz,omitempty"`
+	// BootSequence, if set, shows a splash screen at startup instead of the loading bar animation.
+	BootSequence *BootSequence `json:"boot_sequence,omitempty"`
+	// PixelBatch and PixelBatchMs have DrawPixel and ClearPixel hold their pixels back and send them together, once
+	// PixelBatch of them have been drawn or PixelBatchMs has passed since the first, whichever comes first. Either
+	// may be left unset; with both unset every pixel is sent straight away.
+	PixelBatch   int `json:"pixel_batch,omitempty"`
+	PixelBatchMs int `json:"pixel_batch_ms,omitempty"`
 }
 
 // Validate ensures all parts of the config are valid.
 func (config *Config) Validate(path string) ([]string, error) {
 	var deps []string
-	if len(config.I2CBus) == 0 {
-		return nil, utils.NewConfigValidationFieldRequiredError(path, "i2c_bus")
+	switch config.Transport {
+	case "", transportI2C:
+		if len(config.I2CBus) == 0 {
+			return nil, utils.NewConfigValidationFieldRequiredError(path, "i2c_bus")
+		}
+	case transportSPI:
+		for _, field := range [][2]string{
+			{"board", config.Board}, {"spi_bus", config.SPIBus}, {"chip_select", config.ChipSelect}, {"dc_pin", config.DCPin},
+		} {
+			if field[1] == "" {
+				return nil, utils.NewConfigValidationFieldRequiredError(path, field[0])
+			}
+		}
+		if config.ControlFraming == framingCo {
+			return nil, utils.NewConfigValidationError(path,
+				fmt.Errorf("control_framing %q only applies to i2c", framingCo))
+		}
+		if config.SPIBaudHz < 0 {
+			return nil, utils.NewConfigValidationError(path,
+				fmt.Errorf("spi_baud_hz must not be negative, got %d", config.SPIBaudHz))
+		}
+		deps = append(deps, config.Board)
+	default:
+		return nil, utils.NewConfigValidationError(path,
+			fmt.Errorf("transport must be %q or %q, got %q", transportI2C, transportSPI, config.Transport))
+	}
+	if _, ok := presets[config.Preset]; !ok && config.Preset != "" {
+		return nil, utils.NewConfigValidationError(path,
+			fmt.Errorf("preset must be one of %s, got %q", presetNames(), config.Preset))
+	}
+	// the rest is checked as the panel will be set up, with the preset filled in
+	resolved := config.withPreset()
+	config = &resolved
+	if config.I2CSpeedHz != 0 {
+		return nil, utils.NewConfigValidationError(path, fmt.Errorf(
+			"i2c_speed_hz is not supported, the bus speed is set by the OS (e.g. dtparam=i2c_arm_baudrate=%d on a Pi)",
+			config.I2CSpeedHz))
+	}
+	if config.AntiGhostInterval < 0 {
+		return nil, utils.NewConfigValidationError(path,
+			fmt.Errorf("anti_ghost_interval must not be negative, got %d", config.AntiGhostInterval))
+	}
+	if config.Width < 0 || config.Width > maxWidth || config.Width%8 != 0 {
+		return nil, utils.NewConfigValidationError(path,
+			fmt.Errorf("width must be a multiple of 8 up to %d, got %d", maxWidth, config.Width))
+	}
+	if config.Height < 0 || config.Height > maxHeight {
+		return nil, utils.NewConfigValidationError(path,
+			fmt.Errorf("height must be between 1 and %d, got %d", maxHeight, config.Height))
+	}
+	if config.InitRetries < 0 {
+		return nil, utils.NewConfigValidationError(path,
+			fmt.Errorf("init_retries must be at least 1, got %d", config.InitRetries))
+	}
+	if config.InitDelayMs < 0 {
+		return nil, utils.NewConfigValidationError(path,
+			fmt.Errorf("init_delay_ms must not be negative, got %d", config.InitDelayMs))
+	}
+	if config.UndoDepth < 0 {
+		return nil, utils.NewConfigValidationError(path,
+			fmt.Errorf("undo_depth must not be negative, got %d", config.UndoDepth))
+	}
+	if config.PixelBatch < 0 || config.PixelBatchMs < 0 {
+		return nil, utils.NewConfigValidationError(path, fmt.Errorf(
+			"pixel_batch and pixel_batch_ms must not be negative, got %d and %d", config.PixelBatch, config.PixelBatchMs))
+	}
+	if _, ok := clearPatterns[config.ClearPattern]; !ok && config.ClearPattern != "" {
+		return nil, utils.NewConfigValidationError(path,
+			fmt.Errorf(`clear_pattern must be "off", "on" or "checker", got %q`, config.ClearPattern))
+	}
+	if _, ok := comScans[config.ComScan]; !ok && config.ComScan != "" {
+		return nil, utils.NewConfigValidationError(path,
+			fmt.Errorf(`com_scan must be "inc" or "dec", got %q`, config.ComScan))
+	}
+	switch config.Rotation {
+	case 0, 90, 180, 270:
+	default:
+		return nil, utils.NewConfigValidationError(path,
+			fmt.Errorf("rotation must be 0, 90, 180 or 270, got %d", config.Rotation))
+	}
+	if config.BootSequence != nil {
+		// the splash goes on the screen as it's seen, after rotation
+		width, height := config.panelSize()
+		if config.Rotation == 90 || config.Rotation == 270 {
+			width, height = height, width
+		}
+		if err := config.BootSequence.validate(width, height); err != nil {
+			return nil, utils.NewConfigValidationError(path, err)
+		}
+	}
+	switch config.TextAnchor {
+	case "", anchorBaseline, anchorTop:
+	default:
+		return nil, utils.NewConfigValidationError(path,
+			fmt.Errorf("text_anchor must be %q or %q, got %q", anchorBaseline, anchorTop, config.TextAnchor))
+	}
+	switch config.ClipMode {
+	case "", clipModeClip, clipModeWrap, clipModeError:
+	default:
+		return nil, utils.NewConfigValidationError(path, fmt.Errorf("clip_mode must be %q, %q or %q, got %q",
+			clipModeClip, clipModeWrap, clipModeError, config.ClipMode))
+	}
+	switch config.ErrorPolicy {
+	case "", policyBestEffort, policyFailFast, policyRetry:
+	default:
+		return nil, utils.NewConfigValidationError(path, fmt.Errorf("error_policy must be %q, %q or %q, got %q",
+			policyBestEffort, policyFailFast, policyRetry, config.ErrorPolicy))
+	}
+	switch config.PageOrder {
+	case "", pageOrderForward, pageOrderReverse:
+	default:
+		return nil, utils.NewConfigValidationError(path,
+			fmt.Errorf("page_order must be %q or %q, got %q", pageOrderForward, pageOrderReverse, config.PageOrder))
+	}
+	switch config.Controller {
+	case "", controllerSH110x:
+	case controllerSSD1306:
+		if config.Height%8 != 0 || config.Height > ssd1306MaxHeight {
+			return nil, utils.NewConfigValidationError(path, fmt.Errorf(
+				"an ssd1306 needs a height that's a multiple of 8 up to %d, got %d", ssd1306MaxHeight, config.Height))
+		}
+	default:
+		return nil, utils.NewConfigValidationError(path, fmt.Errorf("controller must be %q or %q, got %q",
+			controllerSH110x, controllerSSD1306, config.Controller))
+	}
+	switch config.ControlFraming {
+	case "", framingStream, framingCo:
+	default:
+		return nil, utils.NewConfigValidationError(path,
+			fmt.Errorf("control_framing must be %q or %q, got %q", framingStream, framingCo, config.ControlFraming))
+	}
+	if err := config.checkOffsets(); err != nil {
+		return nil, utils.NewConfigValidationError(path, err)
 	}
 	return deps, nil
 }
 
+// panelSize returns the panel's width and height, defaultGeometry's where they aren't set.
+func (config *Config) panelSize() (int, int) {
+	width, height := config.Width, config.Height
+	if width == 0 {
+		width = defaultGeometry.Width
+	}
+	if height == 0 {
+		height = defaultGeometry.Height
+	}
+	return width, height
+}
+
+// checkOffsets checks that the panel's visible area, after column_offset and page_offset, fits the controller's RAM.
+// An SSD1306 has 128 columns and 8 pages of 8 rows; an SH110x pages across the width, 16 of them, with a column for
+// each of 128 rows.
+func (config *Config) checkOffsets() error {
+	if config.ColumnOffset < 0 || config.PageOffset < 0 {
+		return fmt.Errorf("column_offset and page_offset must not be negative, got %d and %d",
+			config.ColumnOffset, config.PageOffset)
+	}
+	width, height := config.panelSize()
+	columns, pages, ramColumns, ramPages := width, (height+7)/8, maxWidth, ssd1306MaxHeight/8
+	if config.Controller != controllerSSD1306 {
+		columns, pages, ramColumns, ramPages = height, width/8, maxHeight, maxWidth/8
+	}
+	if config.ColumnOffset+columns > ramColumns || config.PageOffset+pages > ramPages {
+		return fmt.Errorf("a %dx%d panel at column_offset %d and page_offset %d doesn't fit the controller's "+
+			"%d columns and %d pages", width, height, config.ColumnOffset, config.PageOffset, ramColumns, ramPages)
+	}
+	return nil
+}
+
 func init() {
 	resource.RegisterComponent(
 		displayapi.API,
@@ -95,296 +480,1361 @@ func newDisplay(
 	attr *Config,
 	logger logging.Logger,
 ) (*display, error) {
+	if attr.Transport == transportSPI {
+		spi, err := newSPIBus(ctx, deps, attr)
+		if err != nil {
+			return nil, err
+		}
+		return newDisplayWithBus(ctx, spi, name, attr, logger)
+	}
 	i2cbus, err := buses.NewI2cBus(attr.I2CBus)
 	if err != nil {
 		return nil, err
 	}
+	return newDisplayWithBus(ctx, i2cbus, name, attr, logger)
+}
+
+// newDisplayWithBus is newDisplay for a bus that's already open, such as a fakei2c.Bus in tests or a spiBus.
+func newDisplayWithBus(
+	ctx context.Context,
+	bus buses.I2C,
+	name resource.Name,
+	attr *Config,
+	logger logging.Logger,
+) (*display, error) {
+	preset := presets[attr.Preset]
+	resolved := attr.withPreset()
+	attr = &resolved
 	addr := attr.I2cAddr
+	spi := attr.Transport == transportSPI
 	if addr == 0 {
 		addr = defaultI2Caddr
-		logger.Warnf("using i2c address : 0x%s", hex.EncodeToString([]byte{byte(addr)}))
+		if !spi {
+			logger.Warnf("using i2c address : 0x%s", hex.EncodeToString([]byte{byte(addr)}))
+		}
 	}
 
 	d := &display{
-		Named:   name.AsNamed(),
-		logger:  logger,
-		bus:     i2cbus,
-		addr:    byte(addr),
-		current: blank(),
+		Named:       name.AsNamed(),
+		logger:      logger,
+		bus:         bus,
+		addr:        byte(addr),
+		geom:        defaultGeometry,
+		fontName:    defaultFont,
+		cmdCtrl:     ctrlCommand,
+		dataCtrl:    ctrlData,
+		allowUnsafe: attr.AllowUnsafeCommands,
+		antiGhost:   attr.AntiGhostInterval,
+		anchorTop:   attr.TextAnchor == anchorTop,
+		smoothText:  attr.SmoothText,
+		busName:     attr.I2CBus,
+		maxTransfer: maxDataTransfer,
+		spi:         spi,
+	}
+	if spi {
+		d.maxTransfer = spiMaxTransfer
+	}
+	d.clearPattern = "all-off"
+	if attr.ClearPattern != "" {
+		d.clearPattern = clearPatterns[attr.ClearPattern]
+	}
+	d.panel = defaultGeometry
+	if attr.Width != 0 {
+		d.panel.Width = attr.Width
+	}
+	if attr.Height != 0 {
+		d.panel.Height = attr.Height
+	}
+	d.ssd1306ComPins = preset.altComPins || d.panel.Height >= ssd1306MaxHeight
+	d.rotation = attr.Rotation
+	d.geom = d.panel.rotated(d.rotation)
+	d.geom.Clip = attr.ClipMode != clipModeWrap
+	d.clipError = attr.ClipMode == clipModeError
+	d.reportClipped = attr.ReportClipped
+	d.controller = controllerSH110x
+	if attr.Controller != "" {
+		d.controller = attr.Controller
+	}
+	d.reversePages = attr.PageOrder == pageOrderReverse
+	d.padDisplayBytes = attr.PadDisplayBytes
+	d.errorPolicy = policyBestEffort
+	if attr.ErrorPolicy != "" {
+		d.errorPolicy = attr.ErrorPolicy
+	}
+	d.history.depth = defaultUndoDepth
+	d.pixelBatch = attr.PixelBatch
+	d.pixelBatchDelay = time.Duration(attr.PixelBatchMs) * time.Millisecond
+	if attr.UndoDepth > 0 {
+		d.history.depth = attr.UndoDepth
+	}
+	d.comScan = sh110xCOMSCANINC
+	if attr.ComScan != "" {
+		d.comScan = comScans[attr.ComScan]
+	}
+	if attr.FlipV {
+		d.comScan = oppositeComScan(d.comScan)
+	}
+	d.flipH = attr.FlipH
+	d.columnOffset = attr.ColumnOffset
+	d.pageOffset = attr.PageOffset
+	// there's no status to read over SPI
+	d.skipResetCheck = attr.SkipResetCheck || spi
+	d.contrast = defaultContrast
+	if level, ok := keptContrast(name); ok {
+		d.contrast = level
+	}
+	d.initDelay = defaultInitDelay
+	if attr.InitDelayMs > 0 {
+		d.initDelay = time.Duration(attr.InitDelayMs) * time.Millisecond
+	}
+	d.current = d.cleared()
+	if attr.ControlFraming == framingCo {
+		d.cmdCtrl |= ctrlCoBit
+		d.dataCtrl |= ctrlCoBit
+		d.maxTransfer = maxDataTransferCo
 	}
 
+	attempts := defaultInitAttempts
+	if attr.InitRetries > 0 {
+		attempts = attr.InitRetries
+	}
 	// Init the display multiple times, hoping at least one works- sometimes it takes several writes to get a good init
-	for i := 0; i < 4; i++ {
-		logger.Warn("init", i)
-		d.initDisp(ctx)
+	var initErrs []error
+	for i := 0; i < attempts; i++ {
+		logger.Debug("init", i)
+		if err := d.initDisp(ctx); err != nil {
+			initErrs = append(initErrs, err)
+		}
+	}
+	if len(initErrs) == attempts {
+		where := fmt.Sprintf("at 0x%02X on i2c bus %s", addr, attr.I2CBus)
+		if spi {
+			where = fmt.Sprintf("on spi bus %s", attr.SPIBus)
+		}
+		return nil, fmt.Errorf("couldn't initialize the display %s: %w", where, errors.Join(initErrs...))
 	}
 
-	if !attr.SkipAnimation {
-		logger.Warn("animation")
+	if attr.SelfCheck {
+		d.selfCheck(ctx)
+	}
+
+	if attr.BootSequence != nil {
+		d.startBoot(ctx, attr.BootSequence)
+	} else if !attr.SkipAnimation && !skipsAnimation(name) {
+		logger.Debug("animation")
 		d.initAnimation(ctx)
 	}
 
 	return d, nil
 }
 
-func blank() []byte {
-	return make([]byte, 1024)
+// skipAnimation holds the displays, by name, that the skip_animation DoCommand told to leave the startup animation
+// out. A rebuild replaces the display, so this has to outlive it.
+var (
+	skipAnimationMu sync.Mutex
+	skipAnimation   = map[resource.Name]bool{}
+)
+
+func skipsAnimation(name resource.Name) bool {
+	skipAnimationMu.Lock()
+	defer skipAnimationMu.Unlock()
+	return skipAnimation[name]
+}
+
+// contrasts holds the level SetContrast last set on each display, by name, so a rebuild after a config change comes
+// back at the same brightness rather than jumping to the default.
+var (
+	contrastsMu sync.Mutex
+	contrasts   = map[resource.Name]byte{}
+)
+
+func keptContrast(name resource.Name) (byte, bool) {
+	contrastsMu.Lock()
+	defer contrastsMu.Unlock()
+	level, ok := contrasts[name]
+	return level, ok
 }
 
 // display is a i2c sensor device that reports voltage, current and power across N channels that should support multiple INA chip models
 type display struct {
 	resource.Named
 	resource.AlwaysRebuild
-	resource.TriviallyCloseable
+	// mu serializes updates to current and the transfers that send it to the panel
+	mu      sync.Mutex
 	logger  logging.Logger
+	busName string
 	bus     buses.I2C
 	addr    byte
-	current []byte
+	// geom is the screen as drawing sees it, panel the panel's own layout, which differ when the image is rotated
+	geom     Geometry
+	panel    Geometry
+	rotation int
+	// the name geom's font is registered under, so font_spacing can tell when it changes the font in use
+	fontName string
+	current  []byte
+	// the test pattern a cleared screen shows
+	clearPattern string
+	// the controller chip, which decides the init sequence and how frames are addressed
+	controller string
+	// where the panel's visible area starts in the controller's RAM
+	columnOffset int
+	pageOffset   int
+	// an SSD1306's COM pins are in the alternative configuration, as on 64 row panels, rather than sequential
+	ssd1306ComPins bool
+	// the COM scan direction command sent during init, which flip_v has already been applied to
+	comScan byte
+	// init remaps the segments the other way round, mirroring the image
+	flipH bool
+	// the contrast level init sets, so a reinit keeps what SetContrast chose
+	contrast byte
+	// draws don't check the status byte for a controller reset
+	skipResetCheck bool
+	// how long init lets the controller settle before turning the panel on
+	initDelay time.Duration
+	// whether SetInvert turned inverse video on, which init reapplies
+	inverted bool
+	// StartScroll has the controller scrolling, which has to stop before the RAM is written
+	scrolling bool
+	// how many ScrollText marquees are running
+	marquees int
+	// SetDisplayOn turned the panel off, so it reads back as off on purpose and init leaves it off
+	displayOff bool
+	// the panel is on SPI, which can't be read, rather than I2C
+	spi bool
+	// the most display data sent in one transfer
+	maxTransfer int
+	// control bytes prefixed to command and data transfers
+	cmdCtrl  byte
+	dataCtrl byte
+	// rate limiting for reinit log lines
+	lastReinitLog     time.Time
+	suppressedReinits int
+	allowUnsafe       bool
+	anchorTop         bool
+	smoothText        bool
+	errorPolicy       string
+	// send pages right to left instead of left to right
+	reversePages bool
+	// DisplayBytes pads or truncates frames of the wrong size rather than rejecting them
+	padDisplayBytes bool
+	// draws that go off the screen fail instead of being clipped
+	clipError bool
+	// count the pixels each draw clips, and how many the last one did
+	reportClipped bool
+	lastClipped   int
+	// flushes since the last anti-ghosting cycle, which runs every antiGhost flushes when set
+	antiGhost int
+	flushes   int
+	// how long recent writeBuf calls spent sending pages, and when one last sent every page without an error
+	timing    flushTiming
+	lastFlush time.Time
+	// frames replaced by draws, for Undo and Redo
+	history frameHistory
+	// the wrapped lines LogLine is showing, oldest first
+	console []string
+	// the boot sequence's splash is on the panel, see startBoot
+	splashUp bool
+	// DrawPixel and ClearPixel hold their pixels back in heldPixels until pixelBatch of them are held or
+	// pixelBatchDelay has passed since the first, when heldTimer sends them, see holdPixel
+	pixelBatch      int
+	pixelBatchDelay time.Duration
+	heldPixels      []func(buf []byte) []byte
+	heldTimer       *time.Timer
+	// cancelBoot stops the goroutine that takes the splash down, which bootWorkers waits for
+	cancelBoot  context.CancelFunc
+	bootWorkers sync.WaitGroup
+	// the pixels the last draw changed
+	changed image.Rectangle
+}
+
+// Close stops the boot sequence if it's still running and waits for it to finish, then sends any pixels DrawPixel is
+// holding back. The panel is otherwise left as it is, and the display that replaces this one initializes it again.
+func (d *display) Close(ctx context.Context) error {
+	if d.cancelBoot != nil {
+		d.cancelBoot()
+	}
+	d.bootWorkers.Wait()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.flushHeldPixels(ctx)
 }
 
 func (d *display) DisplayBytes(ctx context.Context, data []byte) error {
-	d.writeBuf(ctx, blank())
-	new := make([]byte, len(d.current))
-	for i, pix := range data {
-		if i >= len(new) {
-			break
-		}
-		new[i] = pix
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(data) != len(d.current) && !d.padDisplayBytes {
+		return fmt.Errorf("DisplayBytes expects a %d byte frame, got %d bytes", len(d.current), len(data))
 	}
-	return d.writeBuf(ctx, new)
+	// a short frame is padded out with blank pixels, a long one cut off
+	frame := d.geom.Blank()
+	copy(frame, data)
+	return d.flush(ctx, frame)
 }
 
-func (d *display) WriteString(ctx context.Context, xloc, yloc int, text string) error {
+func (d *display) DisplayBytesRLE(ctx context.Context, encoded []byte) error {
+	data, err := DecodeRLE(encoded)
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(data) != len(d.current) {
+		return fmt.Errorf("run-length data decodes to %d bytes, expected a %d byte frame", len(data), len(d.current))
+	}
+	return d.flush(ctx, data)
+}
+
+// DisplayBytesDirty shows a full frame like DisplayBytesRLE, but only sends the pages overlapping the given rectangle,
+// for clients that know exactly what changed. The rest of data is ignored and the panel keeps what it had there.
+func (d *display) DisplayBytesDirty(ctx context.Context, data []byte, dirtyX, dirtyY, dirtyW, dirtyH int) error {
+	if err := d.checkOnScreen(dirtyX, dirtyY, dirtyW, dirtyH); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(data) != len(d.current) {
+		return fmt.Errorf("got a %d byte frame, expected %d bytes", len(data), len(d.current))
+	}
+	// pages are 8 pixel wide column bands, so only x decides which ones the rectangle touches
+	first, last := dirtyX/8, (dirtyX+dirtyW-1)/8
 	new := make([]byte, len(d.current))
 	copy(new, d.current)
+	start, end := d.geom.index(first*8, 0), d.geom.index(last*8, d.geom.Height-1)+1
+	copy(new[start:end], data[start:end])
+	return d.flushPages(ctx, new, first, last)
+}
 
-	new = writeString(xloc, yloc, text, new)
-	return d.writeBuf(ctx, new)
+// DisplayScaled shows a frame made for a smaller srcW x srcH panel, packed the same way as a DisplayBytes frame for
+// that size, blown up by factor and centered on a blank screen.
+func (d *display) DisplayScaled(ctx context.Context, data []byte, srcW, srcH, factor int) error {
+	if srcW <= 0 || srcH <= 0 || factor <= 0 {
+		return fmt.Errorf("source size and factor must be positive, got %dx%d by %d", srcW, srcH, factor)
+	}
+	if srcW*factor > d.geom.Width || srcH*factor > d.geom.Height {
+		return fmt.Errorf("%dx%d scaled by %d is %dx%d, which doesn't fit the %dx%d display",
+			srcW, srcH, factor, srcW*factor, srcH*factor, d.geom.Width, d.geom.Height)
+	}
+	if want := len(NewBuffer(srcW, srcH)); len(data) != want {
+		return fmt.Errorf("a %dx%d frame is %d bytes, got %d", srcW, srcH, want, len(data))
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	x, y := (d.geom.Width-srcW*factor)/2, (d.geom.Height-srcH*factor)/2
+	// a whole frame, so like DisplayBytes it's in screen pixels whatever SetScale says
+	geom := d.geom
+	geom.Scale = 0
+	return d.flush(ctx, geom.WriteUpscaled(x, y, srcW, srcH, factor, data, geom.Blank()))
 }
 
-func (d *display) DrawLine(ctx context.Context, x1, y1, x2, y2 int) error {
+// GetDimensions returns the size of the screen as drawing sees it, which is the panel turned by the rotation.
+func (d *display) GetDimensions(ctx context.Context) (int, int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.geom.Width, d.geom.Height, nil
+}
+
+// GetPixel reports whether the pixel at (x, y) is lit on the screen, wrapping and scaling the coordinates the same way
+// drawing does, so it reads back exactly the pixel a draw call at (x, y) would have set.
+func (d *display) GetPixel(ctx context.Context, x, y int) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.geom.Pixel(x, y, d.current), nil
+}
+
+func (d *display) GetRegion(ctx context.Context, x, y, w, h int) ([]byte, error) {
+	if err := d.checkOnScreen(x, y, w, h); err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.geom.Region(x, y, w, h, d.current), nil
+}
+
+// ReadBuffer returns a copy of the frame on the screen, in the format DisplayBytes takes.
+func (d *display) ReadBuffer(ctx context.Context) ([]byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	buf := make([]byte, len(d.current))
+	copy(buf, d.current)
+	return buf, nil
+}
+
+// SetRegion writes a region in the format GetRegion returns back to the screen, sending only the pages it overlaps.
+func (d *display) SetRegion(ctx context.Context, x, y, w, h int, data []byte) error {
+	if err := d.checkOnScreen(x, y, w, h); err != nil {
+		return err
+	}
+	if want := len(NewBuffer(w, h)); len(data) != want {
+		return fmt.Errorf("a %dx%d region is %d bytes, got %d", w, h, want, len(data))
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
 	new := make([]byte, len(d.current))
 	copy(new, d.current)
-	new = writeLine(x1, y1, x2, y2, new)
-	return d.writeBuf(ctx, new)
+	return d.flushPages(ctx, d.geom.WriteRegion(x, y, w, h, data, new), x/8, (x+w-1)/8)
+}
+
+// checkOnScreen checks the w x h rectangle at (x, y) is non-empty and lies entirely on the screen.
+func (d *display) checkOnScreen(x, y, w, h int) error {
+	if w <= 0 || h <= 0 || x < 0 || y < 0 || x+w > d.geom.Width || y+h > d.geom.Height {
+		return fmt.Errorf("rectangle %dx%d at (%d, %d) is not inside the %dx%d display",
+			w, h, x, y, d.geom.Width, d.geom.Height)
+	}
+	return nil
+}
+
+func (d *display) WriteString(ctx context.Context, xloc, yloc int, text string) error {
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteString(xloc, d.baseline(yloc), text, buf)
+	})
+}
+
+// WriteStringDouble writes text like WriteString at twice the size, for code ported from libraries with hardware
+// double size text.
+func (d *display) WriteStringDouble(ctx context.Context, xloc, yloc int, text string) error {
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.writeStringScaled(xloc, d.scaledBaseline(yloc, 2), 2, text, buf)
+	})
+}
+
+// WriteStringScaled writes text like WriteString at factor times the size, for readouts that have to be read from
+// across a room.
+func (d *display) WriteStringScaled(ctx context.Context, xloc, yloc, factor int, text string) error {
+	if factor < 1 {
+		return fmt.Errorf("text scale factor must be at least 1, got %d", factor)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.writeStringScaled(xloc, d.scaledBaseline(yloc, factor), factor, text, buf)
+	})
+}
+
+// WriteStringCursor writes text like WriteString and returns where the next text should start to follow on from it.
+func (d *display) WriteStringCursor(ctx context.Context, xloc, yloc int, text string) (int, int, error) {
+	var next int
+	err := d.draw(ctx, func(buf []byte) []byte {
+		next = xloc + d.geom.textWidth(text)
+		return d.geom.WriteString(xloc, d.baseline(yloc), text, buf)
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return next, yloc, nil
+}
+
+// WriteStringWrapped writes text word wrapped to maxWidth pixels, breaking inside words too long for a line, and
+// returns the number of lines it took.
+func (d *display) WriteStringWrapped(ctx context.Context, xloc, yloc int, text string, maxWidth int) (int, error) {
+	if maxWidth <= 0 {
+		return 0, fmt.Errorf("wrap width must be positive, got %d", maxWidth)
+	}
+	var lines int
+	err := d.draw(ctx, func(buf []byte) []byte {
+		buf, lines = d.geom.WriteStringWrapped(xloc, d.baseline(yloc), text, maxWidth, buf)
+		return buf
+	})
+	return lines, err
+}
+
+// MeasureString returns how wide text is in the current font and how far apart lines of it are, without drawing it.
+func (d *display) MeasureString(ctx context.Context, text string) (int, int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.geom.textWidth(text), d.geom.lineHeight(), nil
+}
+
+func (d *display) WriteStringRotated(ctx context.Context, x, y, angleDeg int, text string) error {
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteStringRotated(x, d.baseline(y), angleDeg, text, buf)
+	})
+}
+
+// WriteStringVertical writes text running "up" the screen or "down" it, starting at (x, y). text_anchor applies
+// across the text as usual, which is sideways on the screen.
+func (d *display) WriteStringVertical(ctx context.Context, x, y int, text, direction string) error {
+	if direction != "up" && direction != "down" {
+		return fmt.Errorf(`text direction must be "up" or "down", got %q`, direction)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		// how far the baseline is from the anchor, towards the bottom of the glyphs
+		drop := -d.baseline(0)
+		if direction == "up" {
+			return d.geom.WriteStringVertical(x+drop, y, text, true, buf)
+		}
+		return d.geom.WriteStringVertical(x-drop, y, text, false, buf)
+	})
+}
+
+func (d *display) WriteStringAlongArc(ctx context.Context, cx, cy, radius, startDeg int, text string) error {
+	if radius <= 0 {
+		return fmt.Errorf("arc radius must be positive, got %d", radius)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteStringAlongArc(cx, cy, radius, startDeg, text, buf)
+	})
+}
+
+func (d *display) WriteStringKnockout(ctx context.Context, barX, barY, barW, barH, textX, textY int, text string) error {
+	if barW < 0 || barH < 0 {
+		return fmt.Errorf("bar width and height must not be negative, got %dx%d", barW, barH)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteStringKnockout(barX, barY, barW, barH, textX, d.baseline(textY), text, buf)
+	})
+}
+
+// baseline converts the y a client passed for text into the baseline row the font is drawn on.
+func (d *display) baseline(y int) int {
+	return d.scaledBaseline(y, 1)
+}
+
+// writeStringScaled writes text factor times the size, smoothed if smooth_text is set. Callers hold d.mu.
+func (d *display) writeStringScaled(x, y, factor int, text string, buf []byte) []byte {
+	if d.smoothText && factor > 1 {
+		return d.geom.WriteStringSmooth(x, y, factor, text, buf)
+	}
+	return d.geom.WriteStringScaled(x, y, factor, text, buf)
+}
+
+// scaledBaseline is baseline for text drawn factor times its normal size.
+func (d *display) scaledBaseline(y, factor int) int {
+	if d.anchorTop {
+		// the top row of glyphs becomes factor rows tall, and y is the top of those
+		return y - d.geom.font().ascent()*factor - (factor - 1)
+	}
+	return y
+}
+
+// DrawPixel turns on the pixel at (x, y), which is scaled and clipped like any other drawing. With pixel_batch or
+// pixel_batch_ms set, the pixel is held back to be sent with others, see holdPixel.
+func (d *display) DrawPixel(ctx context.Context, x, y int) error {
+	return d.drawPixel(ctx, func(buf []byte) []byte {
+		return d.geom.WritePixel(x, y, buf)
+	})
+}
+
+// ClearPixel turns off the pixel at (x, y), the counterpart of DrawPixel.
+func (d *display) ClearPixel(ctx context.Context, x, y int) error {
+	return d.drawPixel(ctx, func(buf []byte) []byte {
+		return d.geom.ClearPixel(x, y, buf)
+	})
+}
+
+// drawPixel is draw for DrawPixel and ClearPixel, which batch their pixels if the config asks for it.
+func (d *display) drawPixel(ctx context.Context, fn func(buf []byte) []byte) error {
+	if d.pixelBatch == 0 && d.pixelBatchDelay == 0 {
+		return d.draw(ctx, fn)
+	}
+	return d.holdPixel(ctx, fn)
+}
+
+func (d *display) DrawLine(ctx context.Context, x1, y1, x2, y2 int) error {
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteLine(x1, y1, x2, y2, buf)
+	})
+}
+
+func (d *display) DrawRect(ctx context.Context, x, y, w, h int) error {
+	if w < 0 || h < 0 {
+		return fmt.Errorf("rect width and height must not be negative, got %dx%d", w, h)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteRect(x, y, w, h, buf)
+	})
+}
+
+func (d *display) FillRect(ctx context.Context, x, y, w, h int) error {
+	if w < 0 || h < 0 {
+		return fmt.Errorf("rect width and height must not be negative, got %dx%d", w, h)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteFillRect(x, y, w, h, buf)
+	})
+}
+
+func (d *display) DrawCircle(ctx context.Context, cx, cy, r int) error {
+	if r < 0 {
+		return fmt.Errorf("circle radius must not be negative, got %d", r)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteCircle(cx, cy, r, buf)
+	})
+}
+
+func (d *display) FillCircle(ctx context.Context, cx, cy, r int) error {
+	if r < 0 {
+		return fmt.Errorf("circle radius must not be negative, got %d", r)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteFillCircle(cx, cy, r, buf)
+	})
+}
+
+func (d *display) DrawEllipse(ctx context.Context, cx, cy, rx, ry int) error {
+	if rx < 0 || ry < 0 {
+		return fmt.Errorf("ellipse radii must not be negative, got %d and %d", rx, ry)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteEllipse(cx, cy, rx, ry, buf)
+	})
+}
+
+func (d *display) FillEllipse(ctx context.Context, cx, cy, rx, ry int) error {
+	if rx < 0 || ry < 0 {
+		return fmt.Errorf("ellipse radii must not be negative, got %d and %d", rx, ry)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteFillEllipse(cx, cy, rx, ry, buf)
+	})
+}
+
+func (d *display) DrawRoundRect(ctx context.Context, x, y, w, h, r int) error {
+	if w < 0 || h < 0 {
+		return fmt.Errorf("rect width and height must not be negative, got %dx%d", w, h)
+	}
+	if r < 0 {
+		return fmt.Errorf("corner radius must not be negative, got %d", r)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteRoundRect(x, y, w, h, r, buf)
+	})
+}
+
+func (d *display) FillRoundRect(ctx context.Context, x, y, w, h, r int) error {
+	if w < 0 || h < 0 {
+		return fmt.Errorf("rect width and height must not be negative, got %dx%d", w, h)
+	}
+	if r < 0 {
+		return fmt.Errorf("corner radius must not be negative, got %d", r)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteFillRoundRect(x, y, w, h, r, buf)
+	})
+}
+
+func (d *display) DrawTriangle(ctx context.Context, x0, y0, x1, y1, x2, y2 int) error {
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteTriangle(x0, y0, x1, y1, x2, y2, buf)
+	})
+}
+
+func (d *display) FillTriangle(ctx context.Context, x0, y0, x1, y1, x2, y2 int) error {
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteFillTriangle(x0, y0, x1, y1, x2, y2, buf)
+	})
+}
+
+func (d *display) DrawBorder(ctx context.Context, x, y, w, h int, style string) error {
+	if w < 0 || h < 0 {
+		return fmt.Errorf("border width and height must not be negative, got %dx%d", w, h)
+	}
+	if !borderStyles[style] {
+		return fmt.Errorf(`unknown border style %q, expected "solid", "dashed", "double" or "rounded"`, style)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		buf, _ = d.geom.WriteBorder(x, y, w, h, style, buf)
+		return buf
+	})
+}
+
+func (d *display) DrawGrid(ctx context.Context, x, y, w, h, cols, rows int) error {
+	if w < 1 || h < 1 {
+		return fmt.Errorf("grid width and height must be positive, got %dx%d", w, h)
+	}
+	if cols < 0 || rows < 0 {
+		return fmt.Errorf("grid columns and rows must not be negative, got %dx%d", cols, rows)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteGrid(x, y, w, h, cols, rows, buf)
+	})
+}
+
+func (d *display) DrawWaterfall(ctx context.Context, x, y, w, h int, column []float64) error {
+	if w < 1 || h < 1 {
+		return fmt.Errorf("waterfall width and height must be positive, got %dx%d", w, h)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteWaterfall(x, y, w, h, column, buf)
+	})
+}
+
+func (d *display) DrawImageRegion(ctx context.Context, dstX, dstY int, src image.Image, srcX, srcY, w, h int) error {
+	if w < 0 || h < 0 {
+		return fmt.Errorf("image region width and height must not be negative, got %dx%d", w, h)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteImageRegion(dstX, dstY, src, srcX, srcY, w, h, buf)
+	})
+}
+
+// DrawBitmap draws img with its bottom left corner at (x, y), thresholded at half brightness and clipped to the
+// screen.
+func (d *display) DrawBitmap(ctx context.Context, img image.Image, x, y int) error {
+	return d.draw(ctx, func(buf []byte) []byte {
+		// like DisplayBytes, an image is in screen pixels whatever SetScale says
+		geom := d.geom
+		geom.Scale = 0
+		return geom.WriteImage(x, y, img, buf)
+	})
+}
+
+// DrawBitmapDithered is DrawBitmap with Floyd-Steinberg dithering, for photos.
+func (d *display) DrawBitmapDithered(ctx context.Context, img image.Image, x, y int) error {
+	return d.draw(ctx, func(buf []byte) []byte {
+		geom := d.geom
+		geom.Scale = 0
+		return geom.WriteImageDithered(x, y, img, buf)
+	})
+}
+
+// DrawBitmapRLE draws a w x h bitmap encoded as EncodeBitmapRLE describes with its bottom left corner at (x, y). Off
+// pixels are cleared, so the bitmap replaces whatever was under it.
+func (d *display) DrawBitmapRLE(ctx context.Context, x, y, w, h int, encoded []byte) error {
+	d.mu.Lock()
+	screenW, screenH := d.geom.Width, d.geom.Height
+	d.mu.Unlock()
+	if w > screenW || h > screenH {
+		return fmt.Errorf("a %dx%d bitmap doesn't fit the %dx%d screen", w, h, screenW, screenH)
+	}
+	bitmap, err := DecodeBitmapRLE(w, h, encoded)
+	if err != nil {
+		return err
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteRegion(x, y, w, h, bitmap, buf)
+	})
+}
+
+// DrawProgressBarLabeled draws a w x h progress bar with rounded ends at (x, y), filled to percent, with label (or
+// the percentage if it's empty) knocked out of the fill so it reads on both sides of the edge.
+func (d *display) DrawProgressBarLabeled(ctx context.Context, x, y, w, h, percent int, label string) error {
+	if w < 5 || h < 5 {
+		return fmt.Errorf("progress bar must be at least 5x5 to fit its outline and fill, got %dx%d", w, h)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteProgressBarLabeled(x, y, w, h, percent, label, buf)
+	})
+}
+
+func (d *display) DrawAxes(ctx context.Context, x, y, w, h int, xLabel, yLabel string, ticks int) error {
+	if w <= 0 || h <= 0 {
+		return fmt.Errorf("axes width and height must be positive, got %dx%d", w, h)
+	}
+	if ticks < 0 {
+		return fmt.Errorf("ticks must not be negative, got %d", ticks)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteAxes(x, y, w, h, xLabel, yLabel, ticks, buf)
+	})
+}
+
+func (d *display) DrawDPad(ctx context.Context, cx, cy, size int, pressed string) error {
+	if size < 3 {
+		return fmt.Errorf("d-pad size must be at least 3 to fit an outline and its inside, got %d", size)
+	}
+	if _, ok := dpadArms[pressed]; !ok && pressed != "" {
+		return fmt.Errorf(`pressed must be "up", "down", "left", "right" or "", got %q`, pressed)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteDPad(cx, cy, size, pressed, buf)
+	})
+}
+
+func (d *display) DrawKeyValues(ctx context.Context, x, y int, keys, values []string, colGap int) error {
+	if len(keys) != len(values) {
+		return fmt.Errorf("every key needs a value, got %d keys and %d values", len(keys), len(values))
+	}
+	if colGap < 0 {
+		return fmt.Errorf("column gap must not be negative, got %d", colGap)
+	}
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteKeyValues(x, d.baseline(y), keys, values, colGap, buf)
+	})
+}
+
+func (d *display) DrawTestPattern(ctx context.Context, pattern string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	new, err := d.geom.TestPattern(pattern)
+	if err != nil {
+		return err
+	}
+	return d.flush(ctx, new)
+}
+
+func (d *display) DrawCalibration(ctx context.Context) error {
+	// a closure, so d.geom is read under d.mu and with draw's clip check in place
+	return d.draw(ctx, func(buf []byte) []byte {
+		return d.geom.WriteCalibration(buf)
+	})
+}
+
+// SetScale zooms everything drawn from now on about the origin. Whole frames sent with DisplayBytes and test
+// patterns aren't scaled, and neither is what's already on the screen.
+func (d *display) SetScale(ctx context.Context, factor float64) error {
+	if factor <= 0 || math.IsInf(factor, 0) || math.IsNaN(factor) {
+		return fmt.Errorf("scale must be a positive number, got %v", factor)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.geom.Scale = factor
+	return nil
+}
+
+// SetFont switches the text methods to the registered font name from now on.
+func (d *display) SetFont(ctx context.Context, name string) error {
+	font, err := lookupFont(name)
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.geom.Font = font
+	d.fontName = name
+	return nil
+}
+
+// SetContrast sets the panel's contrast, clamped to 0-255, and keeps it across reinitialization and rebuilds.
+func (d *display) SetContrast(ctx context.Context, level int) error {
+	if level < 0 {
+		level = 0
+	}
+	if level > 255 {
+		level = 255
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.contrast = byte(level)
+	contrastsMu.Lock()
+	contrasts[d.Name()] = d.contrast
+	contrastsMu.Unlock()
+	return d.sendCommand(ctx, sh110xSETCONTRAST, d.contrast)
+}
+
+// SetInvert switches the panel between normal and inverse video, in which off pixels are lit and on pixels are dark.
+// The frame buffer isn't touched, so GetPixel and the like still report what was drawn.
+func (d *display) SetInvert(ctx context.Context, invert bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.inverted = invert
+	return d.sendCommand(ctx, d.displayMode())
+}
+
+// SetDisplayOn turns the panel on or off. The controller keeps its RAM while the panel is off and drawing carries on
+// as normal, so turning it back on shows the latest frame.
+func (d *display) SetDisplayOn(ctx context.Context, on bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.displayOff = !on
+	if on {
+		return d.sendCommand(ctx, sh110xDISPLAYON)
+	}
+	return d.sendCommand(ctx, sh110xDISPLAYOFF)
+}
+
+// Undo puts back the frame from before the last draw.
+func (d *display) Undo(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	frame, ok := d.history.back(d.current)
+	if !ok {
+		return fmt.Errorf("nothing to undo")
+	}
+	return d.writeBuf(ctx, frame)
+}
+
+// Redo puts back the frame the last Undo removed.
+func (d *display) Redo(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	frame, ok := d.history.forward(d.current)
+	if !ok {
+		return fmt.Errorf("nothing to redo")
+	}
+	return d.writeBuf(ctx, frame)
+}
+
+// Refresh sends the current buffer to the panel again as is, e.g. after it lost its RAM contents.
+func (d *display) Refresh(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.writeBuf(ctx, d.current)
+}
+
+// Clear blanks the screen, to the configured clear pattern, without reinitializing the controller like Reset does.
+func (d *display) Clear(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.flush(ctx, d.cleared())
 }
 
 func (d *display) Reset(ctx context.Context) error {
-	d.initDisp(ctx)
-	return d.writeBuf(ctx, blank())
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.endSplash(ctx)
+	if err := d.initDisp(ctx); err != nil {
+		return err
+	}
+	return d.writeBuf(ctx, d.cleared())
+}
+
+// cleared returns a buffer holding the configured clear pattern.
+func (d *display) cleared() []byte {
+	buf, err := d.geom.TestPattern(d.clearPattern)
+	if err != nil {
+		// clear_pattern is validated, so this can only be a bug
+		d.logger.Error(err)
+		return d.geom.Blank()
+	}
+	return buf
 }
 
 func (d *display) initDisp(ctx context.Context) error {
+	if d.controller == controllerSSD1306 {
+		return d.initSSD1306(ctx)
+	}
 	handle, err := d.bus.OpenHandle(d.addr)
 	if err != nil {
 		return err
 	}
 	defer utils.UncheckedErrorFunc(handle.Close)
 	// set contrast
-	contrast := []byte{0, 0x81, 0x2F}
-	handle.Write(ctx, contrast)
+	contrast := d.command(sh110xSETCONTRAST, d.contrast)
+	if err := handle.Write(ctx, contrast); err != nil {
+		return err
+	}
 
-	init := []byte{
-		0x00,
+	init := d.command(
 		sh110xDISPLAYOFF,               // 0xAE
 		sh110xSETDISPLAYCLOCKDIV, 0x51, // 0xd5, 0x51,
-		sh110xMEMORYMODE,        // 0x20
-		sh110xSETCONTRAST, 0x4F, // 0x81, 0x4F
+		sh110xMEMORYMODE,              // 0x20
+		sh110xSETCONTRAST, d.contrast, // 0x81, 0x4F by default
 		sh110xDCDC, 0x8A, // 0xAD, 0x8A
-		sh110xSEGREMAP,              // 0xA0
-		sh110xCOMSCANINC,            // 0xC0
+		d.segRemap(),                // 0xA0, or 0xA1 if flipped
+		d.comScan,                   // 0xC0 or 0xC8
 		sh110xSETDISPSTARTLINE, 0x0, // 0xDC 0x00
 		sh110xSETDISPLAYOFFSET, 0x60, // 0xd3, 0x60,
 		sh110xSETPRECHARGE, 0x22, // 0xd9, 0x22,
 		sh110xSETVCOMDETECT, 0x35, // 0xdb, 0x35,
-		sh110xSETMULTIPLEX, 0x3F, // 0xa8, 0x3f,
+		sh110xSETMULTIPLEX, byte(d.panel.Height-1), // 0xa8, 0x3f for 64 rows
 		sh110xDISPLAYALLONRESUME, // 0xa4
-		sh110xNORMALDISPLAY,      // 0xa6
-	}
+		d.displayMode(),          // 0xa6, or 0xa7 if inverted
+	)
 
-	handle.Write(ctx, init)
+	if err := handle.Write(ctx, init); err != nil {
+		return err
+	}
 
-	time.Sleep(100 * time.Millisecond)
+	time.Sleep(d.initDelay)
 
-	// turn on
-	handle.Write(ctx, []byte{0x00, 0xAF})
+	// turn on, unless SetDisplayOn has it off
+	if !d.displayOff {
+		return handle.Write(ctx, d.command(sh110xDISPLAYON))
+	}
 	return nil
 }
 
-func (d *display) checkInit(ctx context.Context) error {
+// checkInit reinitializes the controller if it has reset itself, e.g. after a brownout, and reports whether it did.
+// It reads the status byte, which has statusDisplayOff set while the panel is off and the controller ID in its low
+// bits, so a reset SH1107 reads 0x47: not busy, off, ID 7. There's no telling a reset from the panel being turned off
+// with SetDisplayOn, so it's left alone then, and skip_reset_check turns the check off altogether.
+func (d *display) checkInit(ctx context.Context) (bool, error) {
+	if d.displayOff || d.skipResetCheck {
+		return false, nil
+	}
+	reset := statusDisplayOff | d.controllerID()
+	status, err := d.readStatus(ctx)
+	if err != nil || status != reset {
+		return false, err
+	}
+	// a glitched read can look the same, so make sure before blanking a panel that's most likely fine
+	if status, err = d.readStatus(ctx); err != nil || status != reset {
+		return false, err
+	}
+	d.logReinit()
+	return true, d.initDisp(ctx)
+}
+
+func (d *display) ReadStatus(ctx context.Context) (byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.readStatus(ctx)
+}
+
+// DetectController guesses the controller type from the ID in its status byte.
+func (d *display) DetectController(ctx context.Context) (string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	status, err := d.readStatus(ctx)
+	if err != nil {
+		return "", err
+	}
+	if name, ok := controllerIDs[status&statusIDMask]; ok {
+		return name, nil
+	}
+	return "", fmt.Errorf("unrecognized controller, status byte 0x%02X (please include it if you open an issue)", status)
+}
+
+// readStatus reads the controller's status byte, which is what a plain read without a register returns.
+func (d *display) readStatus(ctx context.Context) (byte, error) {
 	handle, err := d.bus.OpenHandle(d.addr)
 	if err != nil {
-		return err
+		return 0, err
+	}
+	buffer, readErr := handle.Read(ctx, 1)
+	if err := handle.Close(); err != nil {
+		return 0, err
+	}
+	if readErr != nil {
+		return 0, readErr
+	}
+	if len(buffer) != 1 {
+		return 0, fmt.Errorf("status read returned %d bytes", len(buffer))
+	}
+	return buffer[0], nil
+}
+
+// logReinit reports that the display had to be reinitialized, at most once per reinitLogInterval. Reinits in between
+// are counted and included in the next report so a flapping display doesn't flood the logs.
+func (d *display) logReinit() {
+	now := time.Now()
+	if !d.lastReinitLog.IsZero() && now.Sub(d.lastReinitLog) < reinitLogInterval {
+		d.suppressedReinits++
+		return
+	}
+	if d.suppressedReinits > 0 {
+		d.logger.Warnf("display lost its init state, reinitializing (%d more reinits in the last %v)",
+			d.suppressedReinits, now.Sub(d.lastReinitLog).Round(time.Second))
+	} else {
+		d.logger.Warn("display lost its init state, reinitializing")
+	}
+	d.lastReinitLog = now
+	d.suppressedReinits = 0
+}
+
+// selfCheck lights every pixel, then shows whether the controller acknowledged a command and a status read, so
+// whoever wired the panel gets an answer without looking at logs. The result is logged too, since a panel with a bus
+// problem may not be able to show it.
+func (d *display) selfCheck(ctx context.Context) {
+	allOn, err := d.geom.TestPattern("all-on")
+	if err != nil {
+		d.logger.Error(err)
+		return
+	}
+	d.writeBuf(ctx, allOn)
+	if !utils.SelectContextOrWait(ctx, selfCheckPatternHold) {
+		return
 	}
-	buffer, _ := handle.Read(ctx, 1)
-	err = handle.Close()
+
+	result := d.geom.Blank()
+	if err := d.probe(ctx); err != nil {
+		d.logger.Errorw("display self check failed", "error", err)
+		result = d.geom.WriteString(10, 36, "BUS", result)
+		result = d.geom.WriteString(10, 8, "ERROR", result)
+	} else {
+		d.logger.Info("display self check passed")
+		result = d.geom.WriteString((d.geom.Width-d.geom.textWidth("OK"))/2, 20, "OK", result)
+	}
+	d.writeBuf(ctx, result)
+	utils.SelectContextOrWait(ctx, selfCheckResultHold)
+	d.writeBuf(ctx, d.cleared())
+}
+
+// probe checks that the controller acknowledges a harmless command and a status read.
+func (d *display) probe(ctx context.Context) error {
+	handle, err := d.bus.OpenHandle(d.addr)
 	if err != nil {
 		return err
 	}
-	if buffer[0] == 71 {
-		d.initDisp(ctx)
+	defer utils.UncheckedErrorFunc(handle.Close)
+	if err := handle.Write(ctx, d.command(sh110xDISPLAYALLONRESUME)); err != nil {
+		return err
+	}
+	if d.spi {
+		// nothing comes back over SPI, so a write going through is all there is to check
+		return nil
 	}
-	return nil
+	_, err = handle.Read(ctx, 1)
+	return err
 }
 
 func (d *display) initAnimation(ctx context.Context) {
-	buf := blank()
-	for i := 1; i < 15; i++ {
+	buf := d.geom.Blank()
+	for i := 1; i < d.geom.pages()-1; i++ {
 		select {
 		case <-ctx.Done():
 			return
 		default:
 		}
-		buf = writeFillRect(i*8, 20, 8, 24, buf)
+		buf = d.geom.WriteFillRect(i*8, (d.geom.Height-24)/2, 8, 24, buf)
 		d.writeBuf(ctx, buf)
 	}
-	d.writeBuf(ctx, blank())
+	d.writeBuf(ctx, d.cleared())
 }
 
-// This actually writes the buffered bytes to the display
-func (d *display) writeBuf(ctx context.Context, buf []byte) error {
-
-	d.checkInit(ctx)
-
-	handle, err := d.bus.OpenHandle(d.addr)
+// draw applies fn to a copy of the current buffer and sends the result to the panel. mu is held for the whole
+// update so concurrent calls can't interleave their writes on the bus or drop each other's changes. With clip_mode
+// "error", nothing is sent if fn drew anything off the screen.
+func (d *display) draw(ctx context.Context, fn func(buf []byte) []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	new, err := d.render(fn)
 	if err != nil {
 		return err
 	}
-	defer utils.UncheckedErrorFunc(handle.Close)
-
-	var reg byte
-	iter := 0
-	for reg = 0xB0; reg <= 0xBF; reg++ {
-		someBytes := []byte{0, reg, 0x10, 0}
-		handle.Write(context.Background(), someBytes)
+	return d.flush(ctx, new)
+}
 
-		someBytes = append([]byte{0x40}, buf[0+iter*64:31+iter*64]...)
-		handle.Write(context.Background(), someBytes)
-		someBytes = append([]byte{0x40}, buf[31+iter*64:62+iter*64]...)
-		handle.Write(context.Background(), someBytes)
+// render applies fn to a copy of the current frame, after any pixels DrawPixel is holding back, which go out with it.
+// With clip_mode "error", it fails if fn drew anything off the screen. Callers hold d.mu.
+func (d *display) render(fn func(buf []byte) []byte) ([]byte, error) {
+	var clipped int
+	if d.clipError || d.reportClipped {
+		d.geom.clipped = &clipped
+		defer func() { d.geom.clipped = nil }()
+	}
+	new := make([]byte, len(d.current))
+	copy(new, d.current)
+	for _, pixel := range d.heldPixels {
+		new = pixel(new)
+	}
+	new = fn(new)
+	if d.reportClipped {
+		d.lastClipped = clipped
+	}
+	if d.clipError && clipped > 0 {
+		return nil, fmt.Errorf("drawing goes off the %dx%d screen", d.geom.Width, d.geom.Height)
+	}
+	return new, nil
+}
 
-		someBytes = []byte{0x40, buf[62+iter*64], buf[63+iter*64]}
-		handle.Write(context.Background(), someBytes)
+// flush sends a newly drawn frame to the panel and remembers the one it replaced for Undo. Callers hold d.mu.
+func (d *display) flush(ctx context.Context, buf []byte) error {
+	return d.flushPages(ctx, buf, 0, d.geom.pages()-1)
+}
 
-		iter++
+// flushPages is flush for a frame that only differs from the current one in pages first through last. Of those, only
+// the pages that actually changed are sent, so small updates don't tie up the bus with the whole frame.
+func (d *display) flushPages(ctx context.Context, buf []byte, first, last int) error {
+	pages := d.geom.changedPages(d.current, buf, first, last)
+	if d.endSplash(ctx) {
+		pages = pageRange(0, d.geom.pages()-1)
 	}
-	d.current = buf
-	return nil
+	prev := d.current
+	err := d.writePages(ctx, buf, pages)
+	if sameFrame(d.current, buf) {
+		// writePages can take the frame and still fail, as with the best-effort policy, and a frame that was taken
+		// has to be undoable like any other
+		d.history.push(prev)
+		d.changed = d.geom.ChangedBounds(prev, buf)
+		// a new frame either has the pixels DrawPixel was holding back, or replaces everything under them
+		d.releaseHeldPixels()
+	}
+	return err
 }
 
-func writePixel(x, y int, buf []byte) []byte {
-	x, y = y, x
+// sameFrame reports whether a and b are the same frame buffer, not just equal ones.
+func sameFrame(a, b []byte) bool {
+	return len(a) > 0 && len(b) > 0 && &a[0] == &b[0]
+}
 
-	WIDTH := 64
-	LENGTH := 128
-	for x >= WIDTH {
-		x -= WIDTH
+// This actually writes the buffered bytes to the display
+func (d *display) writeBuf(ctx context.Context, buf []byte) error {
+	return d.writePages(ctx, buf, pageRange(0, d.geom.pages()-1))
+}
+
+// writePages sends the given pages of buf, in ascending order (descending with page_order reverse), to the panel
+// and makes buf the current frame, so the other pages of buf must already match what the panel shows.
+func (d *display) writePages(ctx context.Context, buf []byte, pages []int) error {
+	// with the best-effort policy, the first thing that went wrong, returned once the frame has been sent anyway
+	var failed error
+
+	reinit, err := d.checkInit(ctx)
+	if err != nil {
+		if d.errorPolicy != policyBestEffort {
+			return fmt.Errorf("checking the controller: %w", err)
+		}
+		failed = err
 	}
-	for x < 0 {
-		x += WIDTH
+	if reinit {
+		// a reset controller has lost what was on the panel
+		pages = pageRange(0, d.panel.pages()-1)
 	}
-	for y >= LENGTH {
-		y -= LENGTH
+
+	if d.scrolling {
+		// the scroll has moved the RAM contents around, so the whole frame has to be rewritten
+		d.sendCommand(ctx, ssd1306DEACTIVATESCROLL)
+		d.scrolling = false
+		pages = pageRange(0, d.panel.pages()-1)
 	}
-	for y < 0 {
-		y += LENGTH
+
+	frame := buf
+	if d.rotation != 0 {
+		// rotating moves pixels between pages, so the whole frame has to go
+		frame, pages = d.panel.rotateFrom(d.geom, buf, d.rotation), pageRange(0, d.panel.pages()-1)
 	}
 
-	idx := x + (y/8)*WIDTH
-	blen := (WIDTH * LENGTH) / 8
-	for idx >= blen {
-		idx -= blen
+	handle, err := d.bus.OpenHandle(d.addr)
+	if err != nil {
+		return err
 	}
+	defer utils.UncheckedErrorFunc(handle.Close)
 
-	buf[idx] |= (1 << (y & 7))
-	return buf
+	start := time.Now()
+	for i := range pages {
+		iter := pages[i]
+		if d.reversePages {
+			iter = pages[len(pages)-1-i]
+		}
+		for _, someBytes := range d.pageTransfers(frame, iter) {
+			if err := d.write(ctx, handle, someBytes); err != nil {
+				if d.errorPolicy != policyBestEffort {
+					return fmt.Errorf("writing page %d: %w", iter, err)
+				}
+				if failed == nil {
+					failed = err
+				}
+			}
+		}
+	}
+	d.timing.add(time.Since(start))
+	if failed == nil {
+		d.lastFlush = time.Now()
+	}
+	d.current = buf
+
+	if d.antiGhost > 0 {
+		d.flushes++
+		if d.flushes >= d.antiGhost {
+			d.flushes = 0
+			if err := d.antiGhostCycle(ctx, handle); err != nil {
+				if d.errorPolicy != policyBestEffort {
+					return fmt.Errorf("clearing ghosting: %w", err)
+				}
+				if failed == nil {
+					failed = err
+				}
+			}
+		}
+	}
+	if failed != nil {
+		return fmt.Errorf("sending the frame failed, the display may show part of it: %w", failed)
+	}
+	return nil
 }
 
-// Write a line.  Bresenham's algorithm
-func writeLine(x0, y0, x1, y1 int, buf []byte) []byte {
-	steep := math.Abs(float64(y1-y0)) > math.Abs(float64(x1-x0))
-	if steep {
-		x0, y0 = y0, x0
-		x1, y1 = y1, x1
+// pageTransfers returns the transfers that address page of frame, laid out as the panel is, and send it.
+func (d *display) pageTransfers(frame []byte, page int) [][]byte {
+	var transfers [][]byte
+	var data []byte
+	if d.controller == controllerSSD1306 {
+		transfers = append(transfers, d.ssd1306Window(page))
+		data = d.panel.ssd1306Band(frame, page)
+	} else {
+		reg := sh110xSETPAGEADDR + byte(page+d.pageOffset)
+		col := byte(d.columnOffset)
+		transfers = append(transfers, d.command(reg, sh110xSETHIGHCOLUMN|col>>4, sh110xSETLOWCOLUMN|col&0x0F))
+		data = frame[page*d.panel.Height : (page+1)*d.panel.Height]
 	}
+	for start := 0; start < len(data); start += d.maxTransfer {
+		end := start + d.maxTransfer
+		if end > len(data) {
+			end = len(data)
+		}
+		transfers = append(transfers, d.data(data[start:end]...))
+	}
+	return transfers
+}
 
-	if x0 > x1 {
-		x0, x1 = x1, x0
-		y0, y1 = y1, y0
+// controllerID is the ID the configured controller reports in its status byte.
+func (d *display) controllerID() byte {
+	if d.controller == controllerSSD1306 {
+		return idSSD1306
 	}
+	return idSH1107
+}
 
-	dx := x1 - x0
-	dy := y1 - y0
-	if dy < 0 {
-		dy *= -1
+// write sends one transfer to the panel, retrying it if the error policy says to.
+func (d *display) write(ctx context.Context, handle buses.I2CHandle, payload []byte) error {
+	err := handle.Write(ctx, payload)
+	if d.errorPolicy != policyRetry {
+		return err
+	}
+	for i := 0; i < writeRetries && err != nil; i++ {
+		if !utils.SelectContextOrWait(ctx, writeRetryDelay) {
+			return ctx.Err()
+		}
+		err = handle.Write(ctx, payload)
 	}
+	return err
+}
 
-	err := dx / 2
-	ystep := -1
+// antiGhostCycle inverts every pixel on the panel and then restores it. Cheap OLEDs can hold a faint image of
+// content that sat in one place for a while, and driving every pixel the other way for a moment clears it. If ctx is
+// done during the hold, the panel is still put back before returning.
+func (d *display) antiGhostCycle(ctx context.Context, handle buses.I2CHandle) error {
+	opposite := sh110xINVERTDISPLAY
+	if d.inverted {
+		opposite = sh110xNORMALDISPLAY
+	}
+	if err := d.write(ctx, handle, d.command(opposite)); err != nil {
+		return err
+	}
+	held := utils.SelectContextOrWait(ctx, antiGhostHold)
+	if err := d.write(ctx, handle, d.command(d.displayMode())); err != nil {
+		return err
+	}
+	if !held {
+		return ctx.Err()
+	}
+	return nil
+}
 
-	if y0 < y1 {
-		ystep = 1
+// segRemap is the segment remap command for the flip_h attribute. An SSD1306 is upright with its segments remapped,
+// so flipping it clears the remap instead.
+func (d *display) segRemap() byte {
+	remap := sh110xSEGREMAP
+	if d.controller == controllerSSD1306 {
+		remap |= 0x01
+	}
+	if d.flipH {
+		remap ^= 0x01
 	}
+	return remap
+}
 
-	for x0 <= x1 {
-		if steep {
-			buf = writePixel(y0, x0, buf)
-		} else {
-			buf = writePixel(x0, y0, buf)
-		}
-		err -= dy
-		if err < 0 {
-			y0 += ystep
-			err += dx
-		}
-		x0++
+// oppositeComScan returns the COM scan direction command that scans the other way to comScan.
+func oppositeComScan(comScan byte) byte {
+	if comScan == sh110xCOMSCANDEC {
+		return sh110xCOMSCANINC
 	}
-	return buf
+	return sh110xCOMSCANDEC
 }
 
-func writeFillRect(x, y, w, h int, buf []byte) []byte {
-	for i := x; i < x+w; i++ {
-		buf = writeLine(i, y, i, y+h, buf)
+// displayMode is the command for the normal or inverse video mode SetInvert chose.
+func (d *display) displayMode() byte {
+	if d.inverted {
+		return sh110xINVERTDISPLAY
 	}
-	return buf
+	return sh110xNORMALDISPLAY
 }
 
-func writeString(x, y int, char string, buf []byte) []byte {
+// command frames the given controller commands for a single I2C write.
+func (d *display) command(cmds ...byte) []byte {
+	return frame(d.cmdCtrl, cmds)
+}
 
-	charBytes := []byte(char)
+// data frames the given display RAM bytes for a single I2C write.
+func (d *display) data(pix ...byte) []byte {
+	return frame(d.dataCtrl, pix)
+}
 
-	for _, cb := range charBytes {
-		charIdx := cb - 0x20
-		if cb < 0x20 || charIdx >= 95 {
-			continue
-		}
-		cInfo := chars[charIdx]
-		// byte offset
-		bo := cInfo[0]
-		w := cInfo[1]
-		h := cInfo[2]
-		adv := cInfo[3]
-		xo := cInfo[4]
-		yo := cInfo[5]
-
-		var bit byte
-		var bits byte
-
-		for yy := 0; yy < h; yy++ {
-			for xx := 0; xx < w; xx++ {
-				if bit&7 == 0 {
-					bits = freemono[bo]
-					bo++
-				}
-				bit++
-				if (bits & 0x80) > 0 {
-					//~ buf = writePixel(x+xo+xx, y+yo+(h-yy), buf)
-					buf = writePixel(x+xo+xx, (y-yo)-yy, buf)
-				}
-				bits <<= 1
-			}
-		}
-		x += adv
+func frame(ctrl byte, payload []byte) []byte {
+	if ctrl&ctrlCoBit == 0 {
+		return append([]byte{ctrl}, payload...)
 	}
-	return buf
+	framed := make([]byte, 0, 2*len(payload))
+	for _, b := range payload {
+		framed = append(framed, ctrl, b)
+	}
+	return framed
 }
@@ -0,0 +1,1099 @@
+package display
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.viam.com/rdk/components/board/genericlinux/buses"
+	"go.viam.com/rdk/logging"
+	"go.viam.com/test"
+
+	"github.com/biotinker/viam-i2c-display/display/api/displayapi"
+	"github.com/biotinker/viam-i2c-display/display/fakei2c"
+)
+
+// newTestDisplay validates attr and builds a display on a fakei2c.Bus whose status reads say an SH1107 is on. It's
+// initialized once, with no animation, and the bus is cleared of the init traffic unless keepInit is set.
+func newTestDisplay(t *testing.T, attr *Config, keepInit bool) (*display, *fakei2c.Bus) {
+	t.Helper()
+	if attr.I2CBus == "" {
+		attr.I2CBus = "1"
+	}
+	attr.SkipAnimation = true
+	if attr.InitRetries == 0 {
+		attr.InitRetries = 1
+	}
+	if attr.InitDelayMs == 0 {
+		attr.InitDelayMs = 1
+	}
+	_, err := attr.Validate("test")
+	test.That(t, err, test.ShouldBeNil)
+
+	bus := fakei2c.NewBus()
+	bus.SetReadData([]byte{idSH1107})
+	d, err := newDisplayWithBus(context.Background(), bus, displayapi.Named("test"), attr, logging.NewTestLogger(t))
+	test.That(t, err, test.ShouldBeNil)
+	t.Cleanup(func() { test.That(t, d.Close(context.Background()), test.ShouldBeNil) })
+	if !keepInit {
+		bus.ClearTransfers()
+	}
+	return d, bus
+}
+
+// transferData returns the data of every transfer on bus, control bytes included.
+func transferData(bus *fakei2c.Bus) [][]byte {
+	var data [][]byte
+	for _, transfer := range bus.Transfers() {
+		data = append(data, transfer.Data)
+	}
+	return data
+}
+
+// sentPages rebuilds what an SH110x was sent from the transfers on bus: the display data written to each page, by the
+// page address command that came before it.
+func sentPages(bus *fakei2c.Bus) map[int][]byte {
+	pages := map[int][]byte{}
+	page := -1
+	for _, data := range transferData(bus) {
+		switch {
+		case data[0] == ctrlCommand && data[1]&0xF0 == sh110xSETPAGEADDR:
+			page = int(data[1] & 0x0F)
+			pages[page] = nil
+		case data[0] == ctrlData:
+			pages[page] = append(pages[page], data[1:]...)
+		}
+	}
+	return pages
+}
+
+// pageOrder lists the SH110x pages addressed on bus, in the order they were sent, once for each time one was.
+func pageOrder(bus *fakei2c.Bus) []int {
+	var pages []int
+	for _, data := range transferData(bus) {
+		if data[0] == ctrlCommand && data[1]&0xF0 == sh110xSETPAGEADDR {
+			pages = append(pages, int(data[1]&0x0F))
+		}
+	}
+	return pages
+}
+
+// sentFrames splits what was sent on bus into frames, for tests where every frame is a full pass over the pages.
+func sentFrames(bus *fakei2c.Bus) [][]byte {
+	var frames [][]byte
+	for _, data := range transferData(bus) {
+		switch {
+		case data[0] == ctrlCommand && data[1] == sh110xSETPAGEADDR:
+			frames = append(frames, nil)
+		case data[0] == ctrlData && len(frames) > 0:
+			frames[len(frames)-1] = append(frames[len(frames)-1], data[1:]...)
+		}
+	}
+	return frames
+}
+
+func TestInitSequence(t *testing.T) {
+	_, bus := newTestDisplay(t, &Config{}, true)
+	test.That(t, transferData(bus), test.ShouldResemble, [][]byte{
+		{ctrlCommand, sh110xSETCONTRAST, defaultContrast},
+		{
+			ctrlCommand,
+			sh110xDISPLAYOFF,
+			sh110xSETDISPLAYCLOCKDIV, 0x51,
+			sh110xMEMORYMODE,
+			sh110xSETCONTRAST, defaultContrast,
+			sh110xDCDC, 0x8A,
+			sh110xSEGREMAP,
+			sh110xCOMSCANINC,
+			sh110xSETDISPSTARTLINE, 0x00,
+			sh110xSETDISPLAYOFFSET, 0x60,
+			sh110xSETPRECHARGE, 0x22,
+			sh110xSETVCOMDETECT, 0x35,
+			sh110xSETMULTIPLEX, 0x3F,
+			sh110xDISPLAYALLONRESUME,
+			sh110xNORMALDISPLAY,
+		},
+		{ctrlCommand, sh110xDISPLAYON},
+	})
+}
+
+func TestComScan(t *testing.T) {
+	for _, tc := range []struct {
+		comScan string
+		flipV   bool
+		want    byte
+	}{
+		{"", false, sh110xCOMSCANINC},
+		{"inc", false, sh110xCOMSCANINC},
+		{"dec", false, sh110xCOMSCANDEC},
+		// flip_v turns whichever direction was picked around
+		{"", true, sh110xCOMSCANDEC},
+		{"dec", true, sh110xCOMSCANINC},
+	} {
+		_, bus := newTestDisplay(t, &Config{ComScan: tc.comScan, FlipV: tc.flipV}, true)
+		init := transferData(bus)[1]
+		// the COM scan command follows the segment remap, after the control byte and nine bytes of setup
+		test.That(t, init[10], test.ShouldEqual, tc.want)
+	}
+}
+
+func TestContrastKeptAcrossRebuild(t *testing.T) {
+	t.Cleanup(func() {
+		contrastsMu.Lock()
+		delete(contrasts, displayapi.Named("test"))
+		contrastsMu.Unlock()
+	})
+	d, _ := newTestDisplay(t, &Config{}, false)
+	test.That(t, d.SetContrast(context.Background(), 120), test.ShouldBeNil)
+
+	// a config change rebuilds the display under the same name, which comes back at the level that was set
+	_, bus := newTestDisplay(t, &Config{}, true)
+	init := transferData(bus)
+	test.That(t, init[0], test.ShouldResemble, []byte{ctrlCommand, sh110xSETCONTRAST, 120})
+	test.That(t, init[1], test.ShouldContain, byte(120))
+	test.That(t, init[1], test.ShouldNotContain, defaultContrast)
+}
+
+func TestDrawPixelSendsItsPage(t *testing.T) {
+	d, bus := newTestDisplay(t, &Config{}, false)
+	test.That(t, d.DrawPixel(context.Background(), 9, 3), test.ShouldBeNil)
+
+	// only the page holding x 8 to 15 changed, so only it goes out, addressed from column 0
+	test.That(t, transferData(bus)[0], test.ShouldResemble, []byte{ctrlCommand, sh110xSETPAGEADDR + 1, 0x10, 0x00})
+	pages := sentPages(bus)
+	test.That(t, pages, test.ShouldHaveLength, 1)
+	want := make([]byte, 64)
+	want[3] = 1 << 1
+	test.That(t, pages[1], test.ShouldResemble, want)
+}
+
+func TestDrawPrimitivesSendTheirPixels(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		name   string
+		draw   func(d *display) error
+		lit    [][2]int
+		unlit  [][2]int
+		nPages int
+	}{
+		{
+			name:   "line",
+			draw:   func(d *display) error { return d.DrawLine(ctx, 0, 0, 15, 0) },
+			lit:    [][2]int{{0, 0}, {7, 0}, {8, 0}, {15, 0}},
+			unlit:  [][2]int{{16, 0}, {0, 1}},
+			nPages: 2,
+		},
+		{
+			name:   "fill rect",
+			draw:   func(d *display) error { return d.FillRect(ctx, 20, 10, 4, 3) },
+			lit:    [][2]int{{20, 10}, {23, 10}, {20, 12}, {23, 12}},
+			unlit:  [][2]int{{19, 10}, {24, 10}, {20, 9}, {20, 13}},
+			nPages: 1,
+		},
+		{
+			name:   "rect",
+			draw:   func(d *display) error { return d.DrawRect(ctx, 40, 20, 10, 5) },
+			lit:    [][2]int{{40, 20}, {49, 20}, {40, 24}, {49, 24}},
+			unlit:  [][2]int{{45, 22}, {50, 20}},
+			nPages: 2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d, bus := newTestDisplay(t, &Config{}, false)
+			test.That(t, tc.draw(d), test.ShouldBeNil)
+			frame, err := d.ReadBuffer(ctx)
+			test.That(t, err, test.ShouldBeNil)
+			for _, p := range tc.lit {
+				test.That(t, d.geom.Pixel(p[0], p[1], frame), test.ShouldBeTrue)
+			}
+			for _, p := range tc.unlit {
+				test.That(t, d.geom.Pixel(p[0], p[1], frame), test.ShouldBeFalse)
+			}
+			// what reached the panel is the frame, page for page
+			pages := sentPages(bus)
+			test.That(t, pages, test.ShouldHaveLength, tc.nPages)
+			for page, data := range pages {
+				test.That(t, data, test.ShouldResemble, frame[page*64:(page+1)*64])
+			}
+		})
+	}
+}
+
+// sentInit reports whether the SH110x init sequence went out on bus.
+func sentInit(bus *fakei2c.Bus) bool {
+	for _, data := range transferData(bus) {
+		if len(data) > 1 && data[0] == ctrlCommand && data[1] == sh110xDISPLAYOFF {
+			return true
+		}
+	}
+	return false
+}
+
+func TestResetCheck(t *testing.T) {
+	ctx := context.Background()
+	reset := statusDisplayOff | idSH1107 // 0x47, 71
+
+	t.Run("reinitializes a reset controller", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{}, false)
+		bus.SetReadData([]byte{reset})
+		test.That(t, d.DrawPixel(ctx, 0, 0), test.ShouldBeNil)
+		test.That(t, sentInit(bus), test.ShouldBeTrue)
+		// the reinit loses the controller's RAM, so the whole frame goes out again
+		pages := sentPages(bus)
+		test.That(t, pages, test.ShouldHaveLength, 16)
+		test.That(t, pages[0][0], test.ShouldEqual, 1)
+	})
+
+	t.Run("leaves a working controller alone", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{}, false)
+		test.That(t, d.DrawPixel(ctx, 0, 0), test.ShouldBeNil)
+		test.That(t, sentInit(bus), test.ShouldBeFalse)
+	})
+
+	t.Run("skip_reset_check", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{SkipResetCheck: true}, false)
+		bus.SetReadData([]byte{reset})
+		test.That(t, d.DrawPixel(ctx, 0, 0), test.ShouldBeNil)
+		test.That(t, sentInit(bus), test.ShouldBeFalse)
+	})
+
+	t.Run("needs the reset to read back twice", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{}, false)
+		reads := 0
+		d.bus = &flakyStatusBus{Bus: bus, status: func() byte {
+			reads++
+			if reads == 1 {
+				return reset
+			}
+			return idSH1107
+		}}
+		test.That(t, d.DrawPixel(ctx, 0, 0), test.ShouldBeNil)
+		test.That(t, reads, test.ShouldEqual, 2)
+		test.That(t, sentInit(bus), test.ShouldBeFalse)
+	})
+}
+
+// flakyStatusBus is a fakei2c.Bus whose status reads come from status, for a status that changes between reads.
+type flakyStatusBus struct {
+	*fakei2c.Bus
+	status func() byte
+}
+
+func (b *flakyStatusBus) OpenHandle(addr byte) (buses.I2CHandle, error) {
+	handle, err := b.Bus.OpenHandle(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &flakyStatusHandle{I2CHandle: handle, status: b.status}, nil
+}
+
+type flakyStatusHandle struct {
+	buses.I2CHandle
+	status func() byte
+}
+
+func (h *flakyStatusHandle) Read(ctx context.Context, count int) ([]byte, error) {
+	data := make([]byte, count)
+	data[0] = h.status()
+	return data, nil
+}
+
+func TestControlFraming(t *testing.T) {
+	t.Run("stream", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{}, true)
+		for _, data := range transferData(bus) {
+			test.That(t, data[0], test.ShouldEqual, ctrlCommand)
+		}
+		bus.ClearTransfers()
+		test.That(t, d.FillRect(context.Background(), 0, 0, 8, 64), test.ShouldBeNil)
+		transfers := transferData(bus)
+		test.That(t, transfers[0][0], test.ShouldEqual, ctrlCommand)
+		for _, data := range transfers[1:] {
+			test.That(t, data[0], test.ShouldEqual, ctrlData)
+			test.That(t, len(data), test.ShouldBeLessThanOrEqualTo, 32)
+		}
+	})
+
+	t.Run("co", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{ControlFraming: framingCo}, true)
+		// every command byte has its own 0x80 control byte, in initDisp as everywhere
+		for _, data := range transferData(bus) {
+			for i := 0; i < len(data); i += 2 {
+				test.That(t, data[i], test.ShouldEqual, ctrlCommand|ctrlCoBit)
+			}
+		}
+		bus.ClearTransfers()
+		test.That(t, d.FillRect(context.Background(), 0, 0, 8, 64), test.ShouldBeNil)
+		transfers := transferData(bus)
+		test.That(t, transfers[0][0], test.ShouldEqual, ctrlCommand|ctrlCoBit)
+		var sent []byte
+		for _, data := range transfers[1:] {
+			// each data byte has its own 0xC0, and a transfer still fits a 32 byte buffer
+			test.That(t, len(data), test.ShouldBeLessThanOrEqualTo, 32)
+			for i := 0; i < len(data); i += 2 {
+				test.That(t, data[i], test.ShouldEqual, ctrlData|ctrlCoBit)
+				sent = append(sent, data[i+1])
+			}
+		}
+		frame, err := d.ReadBuffer(context.Background())
+		test.That(t, err, test.ShouldBeNil)
+		test.That(t, sent, test.ShouldResemble, frame[:64])
+	})
+}
+
+func TestAntiGhostCycle(t *testing.T) {
+	// modeCommands lists the invert and normal display commands sent on bus, in order.
+	modeCommands := func(bus *fakei2c.Bus) []byte {
+		var modes []byte
+		for _, data := range transferData(bus) {
+			if len(data) == 2 && data[0] == ctrlCommand && (data[1] == sh110xINVERTDISPLAY || data[1] == sh110xNORMALDISPLAY) {
+				modes = append(modes, data[1])
+			}
+		}
+		return modes
+	}
+
+	t.Run("every N flushes", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{AntiGhostInterval: 2}, false)
+		for i := 0; i < 4; i++ {
+			test.That(t, d.DrawPixel(context.Background(), i, 0), test.ShouldBeNil)
+		}
+		test.That(t, modeCommands(bus), test.ShouldResemble,
+			[]byte{sh110xINVERTDISPLAY, sh110xNORMALDISPLAY, sh110xINVERTDISPLAY, sh110xNORMALDISPLAY})
+	})
+
+	t.Run("cancelled during the hold", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{AntiGhostInterval: 1, ErrorPolicy: policyFailFast}, false)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := d.DrawPixel(ctx, 0, 0)
+		test.That(t, err, test.ShouldBeError)
+		test.That(t, errors.Is(err, context.Canceled), test.ShouldBeTrue)
+		// the panel isn't left inverted
+		test.That(t, modeCommands(bus), test.ShouldResemble, []byte{sh110xINVERTDISPLAY, sh110xNORMALDISPLAY})
+	})
+}
+
+func TestDrawCalibrationClipError(t *testing.T) {
+	ctx := context.Background()
+	d, _ := newTestDisplay(t, &Config{ClipMode: clipModeError}, false)
+	test.That(t, d.SetScale(ctx, 2), test.ShouldBeNil)
+	before, err := d.ReadBuffer(ctx)
+	test.That(t, err, test.ShouldBeNil)
+	// at twice the size the corner markers are off the screen
+	test.That(t, d.DrawCalibration(ctx), test.ShouldBeError)
+	after, err := d.ReadBuffer(ctx)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, after, test.ShouldResemble, before)
+}
+
+// TestGeometryReadsUnderLock is for the race detector: draws that read the geometry run alongside calls that change it.
+func TestGeometryReadsUnderLock(t *testing.T) {
+	ctx := context.Background()
+	d, _ := newTestDisplay(t, &Config{}, false)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 20; i++ {
+			test.That(t, d.SetScale(ctx, float64(1+i%2)), test.ShouldBeNil)
+		}
+	}()
+	for i := 0; i < 20; i++ {
+		test.That(t, d.DrawCalibration(ctx), test.ShouldBeNil)
+		test.That(t, d.DrawTestPattern(ctx, "checkerboard"), test.ShouldBeNil)
+		_, _, err := d.WriteStringCursor(ctx, 0, 20, "hi")
+		test.That(t, err, test.ShouldBeNil)
+	}
+	<-done
+}
+
+func TestErrorPolicy(t *testing.T) {
+	ctx := context.Background()
+	unplugged := errors.New("unplugged")
+
+	t.Run("best-effort", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{ErrorPolicy: policyBestEffort}, false)
+		flaky := &flakyWriteBus{Bus: bus, failures: 1}
+		d.bus = flaky
+		err := d.DrawPixel(ctx, 3, 5)
+		test.That(t, errors.Is(err, errFlakyWrite), test.ShouldBeTrue)
+		// the frame is taken anyway, and can be undone like any other
+		buf, err := d.ReadBuffer(ctx)
+		test.That(t, err, test.ShouldBeNil)
+		test.That(t, buf[5], test.ShouldEqual, 1<<3)
+		test.That(t, d.changedBounds(), test.ShouldResemble, map[string]interface{}{"x": 3, "y": 5, "w": 1, "h": 1})
+		test.That(t, d.Undo(ctx), test.ShouldBeNil)
+		buf, err = d.ReadBuffer(ctx)
+		test.That(t, err, test.ShouldBeNil)
+		test.That(t, buf[5], test.ShouldEqual, 0)
+	})
+
+	t.Run("fail-fast", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{ErrorPolicy: policyFailFast}, false)
+		bus.SetError(unplugged)
+		err := d.DrawPixel(ctx, 3, 5)
+		test.That(t, errors.Is(err, unplugged), test.ShouldBeTrue)
+		buf, err := d.ReadBuffer(ctx)
+		test.That(t, err, test.ShouldBeNil)
+		test.That(t, buf[5], test.ShouldEqual, 0)
+		test.That(t, d.Undo(ctx), test.ShouldBeError)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{ErrorPolicy: policyRetry}, false)
+		flaky := &flakyWriteBus{Bus: bus, failures: writeRetries}
+		d.bus = flaky
+		// a write that fails no more than writeRetries times gets through
+		test.That(t, d.DrawPixel(ctx, 3, 5), test.ShouldBeNil)
+		test.That(t, flaky.failures, test.ShouldEqual, 0)
+		test.That(t, sentPages(bus)[0][5], test.ShouldEqual, 1<<3)
+
+		flaky.failures = writeRetries + 1
+		err := d.DrawPixel(ctx, 4, 5)
+		test.That(t, errors.Is(err, errFlakyWrite), test.ShouldBeTrue)
+		buf, err := d.ReadBuffer(ctx)
+		test.That(t, err, test.ShouldBeNil)
+		test.That(t, buf[5], test.ShouldEqual, 1<<3)
+	})
+}
+
+var errFlakyWrite = errors.New("write failed")
+
+// flakyWriteBus is a fakei2c.Bus whose next failures writes fail with errFlakyWrite.
+type flakyWriteBus struct {
+	*fakei2c.Bus
+	failures int
+}
+
+func (b *flakyWriteBus) OpenHandle(addr byte) (buses.I2CHandle, error) {
+	handle, err := b.Bus.OpenHandle(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &flakyWriteHandle{I2CHandle: handle, bus: b}, nil
+}
+
+type flakyWriteHandle struct {
+	buses.I2CHandle
+	bus *flakyWriteBus
+}
+
+func (h *flakyWriteHandle) Write(ctx context.Context, tx []byte) error {
+	if h.bus.failures > 0 {
+		h.bus.failures--
+		return errFlakyWrite
+	}
+	return h.I2CHandle.Write(ctx, tx)
+}
+
+func TestStartScroll(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ssd1306", func(t *testing.T) {
+		// a 32 row panel two pages down the controller's RAM
+		d, bus := newTestDisplay(t, &Config{Controller: controllerSSD1306, Width: 128, Height: 32, PageOffset: 2}, false)
+		test.That(t, d.StartScroll(ctx, "left", 1, 3), test.ShouldBeNil)
+		test.That(t, transferData(bus), test.ShouldResemble, [][]byte{
+			{ctrlCommand, ssd1306DEACTIVATESCROLL},
+			{ctrlCommand, ssd1306LEFTHORIZONTALSCROLL, 0x00, 3, 0x00, 5, 0x00, 0xFF},
+			{ctrlCommand, ssd1306ACTIVATESCROLL},
+		})
+		test.That(t, d.StartScroll(ctx, "left", 1, 4), test.ShouldBeError)
+	})
+
+	t.Run("sh110x", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{}, false)
+		test.That(t, d.StartScroll(ctx, "left", 0, 7), test.ShouldBeError)
+		test.That(t, transferData(bus), test.ShouldBeEmpty)
+	})
+}
+
+func TestClippedPixels(t *testing.T) {
+	ctx := context.Background()
+	get := map[string]interface{}{"get": "clipped_pixels"}
+	d, _ := newTestDisplay(t, &Config{ReportClipped: true}, false)
+
+	// the right 8 columns of a 16x4 rectangle hang off the 128 pixel wide screen
+	test.That(t, d.FillRect(ctx, 120, 0, 16, 4), test.ShouldBeNil)
+	result, err := d.DoCommand(ctx, get)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, result, test.ShouldResemble, map[string]interface{}{"clipped_pixels": 32})
+
+	test.That(t, d.FillRect(ctx, 0, 0, 16, 4), test.ShouldBeNil)
+	result, err = d.DoCommand(ctx, get)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, result, test.ShouldResemble, map[string]interface{}{"clipped_pixels": 0})
+
+	// it's opt in
+	d, _ = newTestDisplay(t, &Config{}, false)
+	_, err = d.DoCommand(ctx, get)
+	test.That(t, err, test.ShouldBeError)
+}
+
+func TestSmoothText(t *testing.T) {
+	ctx := context.Background()
+	d, _ := newTestDisplay(t, &Config{SmoothText: true}, false)
+	g := d.geom
+	test.That(t, d.WriteStringScaled(ctx, 2, 10, 2, "/"), test.ShouldBeNil)
+	buf, err := d.ReadBuffer(ctx)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, buf, test.ShouldResemble, g.WriteStringSmooth(2, 10, 2, "/", g.Blank()))
+
+	// text at its normal size is left as the font draws it
+	test.That(t, d.Clear(ctx), test.ShouldBeNil)
+	test.That(t, d.WriteStringScaled(ctx, 2, 10, 1, "/"), test.ShouldBeNil)
+	buf, err = d.ReadBuffer(ctx)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, buf, test.ShouldResemble, g.WriteString(2, 10, "/", g.Blank()))
+}
+
+func TestPixelBatch(t *testing.T) {
+	ctx := context.Background()
+	lit := func(d *display, x, y int) bool {
+		buf, err := d.ReadBuffer(ctx)
+		test.That(t, err, test.ShouldBeNil)
+		return d.geom.Pixel(x, y, buf)
+	}
+
+	t.Run("sent once N pixels are held", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{PixelBatch: 4}, false)
+		for i := 0; i < 3; i++ {
+			test.That(t, d.DrawPixel(ctx, i*9, 0), test.ShouldBeNil)
+		}
+		test.That(t, transferData(bus), test.ShouldBeEmpty)
+		test.That(t, d.DrawPixel(ctx, 27, 0), test.ShouldBeNil)
+		// one flush, which sent the four pages the pixels are in
+		test.That(t, d.history.undo, test.ShouldHaveLength, 1)
+		pages := sentPages(bus)
+		test.That(t, pages, test.ShouldHaveLength, 4)
+		for i := 0; i < 4; i++ {
+			test.That(t, pages[i][0], test.ShouldEqual, 1<<(i*9%8))
+		}
+	})
+
+	t.Run("sent after the delay", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{PixelBatch: 100, PixelBatchMs: 10}, false)
+		test.That(t, d.DrawPixel(ctx, 3, 5), test.ShouldBeNil)
+		deadline := time.Now().Add(5 * time.Second)
+		for !lit(d, 3, 5) && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		test.That(t, lit(d, 3, 5), test.ShouldBeTrue)
+		test.That(t, sentPages(bus)[0][5], test.ShouldEqual, 1<<3)
+	})
+
+	t.Run("sent with the next draw", func(t *testing.T) {
+		d, _ := newTestDisplay(t, &Config{PixelBatch: 100}, false)
+		test.That(t, d.DrawPixel(ctx, 3, 5), test.ShouldBeNil)
+		test.That(t, d.ClearPixel(ctx, 3, 5), test.ShouldBeNil)
+		test.That(t, d.DrawPixel(ctx, 4, 5), test.ShouldBeNil)
+		test.That(t, lit(d, 4, 5), test.ShouldBeFalse)
+		test.That(t, d.DrawLine(ctx, 0, 10, 10, 10), test.ShouldBeNil)
+		test.That(t, lit(d, 3, 5), test.ShouldBeFalse)
+		test.That(t, lit(d, 4, 5), test.ShouldBeTrue)
+		test.That(t, lit(d, 5, 10), test.ShouldBeTrue)
+	})
+
+	t.Run("sent on Close", func(t *testing.T) {
+		d, bus := newTestDisplay(t, &Config{PixelBatch: 100}, false)
+		test.That(t, d.DrawPixel(ctx, 3, 5), test.ShouldBeNil)
+		test.That(t, d.Close(ctx), test.ShouldBeNil)
+		test.That(t, sentPages(bus)[0][5], test.ShouldEqual, 1<<3)
+	})
+}
+
+func TestAnimating(t *testing.T) {
+	ctx := context.Background()
+	get := map[string]interface{}{"get": "animating"}
+	animating := func(d *display) map[string]interface{} {
+		result, err := d.DoCommand(ctx, get)
+		test.That(t, err, test.ShouldBeNil)
+		return result
+	}
+	idle := map[string]interface{}{"animating": false, "kind": ""}
+
+	t.Run("scroll", func(t *testing.T) {
+		d, _ := newTestDisplay(t, &Config{}, false)
+		test.That(t, animating(d), test.ShouldResemble, idle)
+		scrollCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error)
+		go func() { done <- d.ScrollText(scrollCtx, 30, "moving", 1000) }()
+		deadline := time.Now().Add(5 * time.Second)
+		for animating(d)["animating"] == false && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		test.That(t, animating(d), test.ShouldResemble, map[string]interface{}{"animating": true, "kind": animationScroll})
+		cancel()
+		test.That(t, errors.Is(<-done, context.Canceled), test.ShouldBeTrue)
+		test.That(t, animating(d), test.ShouldResemble, idle)
+	})
+
+	t.Run("hardware scroll", func(t *testing.T) {
+		d, _ := newTestDisplay(t, &Config{Controller: controllerSSD1306}, false)
+		test.That(t, d.StartScroll(ctx, "left", 0, 7), test.ShouldBeNil)
+		test.That(t, animating(d), test.ShouldResemble,
+			map[string]interface{}{"animating": true, "kind": animationHardwareScroll})
+		test.That(t, d.StopScroll(ctx), test.ShouldBeNil)
+		test.That(t, animating(d), test.ShouldResemble, idle)
+	})
+
+	t.Run("splash", func(t *testing.T) {
+		d, _ := newTestDisplay(t, &Config{BootSequence: litSplash(60000)}, false)
+		test.That(t, animating(d), test.ShouldResemble, map[string]interface{}{"animating": true, "kind": animationSplash})
+		test.That(t, d.DrawPixel(ctx, 0, 0), test.ShouldBeNil)
+		test.That(t, animating(d), test.ShouldResemble, idle)
+	})
+}
+
+func TestDisplayBytesWritesOnce(t *testing.T) {
+	ctx := context.Background()
+	d, bus := newTestDisplay(t, &Config{}, false)
+	frame := bytes.Repeat([]byte{0xFF}, len(d.current))
+	test.That(t, d.DisplayBytes(ctx, frame), test.ShouldBeNil)
+	// every page once, with the new content and no blank frame before it
+	test.That(t, pageOrder(bus), test.ShouldResemble, pageRange(0, 15))
+	for _, data := range sentPages(bus) {
+		test.That(t, data, test.ShouldResemble, bytes.Repeat([]byte{0xFF}, 64))
+	}
+
+	bus.ClearTransfers()
+	frame = append([]byte(nil), frame...)
+	frame[3*64] = 0
+	test.That(t, d.DisplayBytes(ctx, frame), test.ShouldBeNil)
+	test.That(t, pageOrder(bus), test.ShouldResemble, []int{3})
+}
+
+func TestDisplayBytesDirty(t *testing.T) {
+	ctx := context.Background()
+	d, bus := newTestDisplay(t, &Config{}, false)
+	frame := bytes.Repeat([]byte{0xFF}, len(d.current))
+	// x 20 to 35 lies in the column bands of pages 2, 3 and 4
+	test.That(t, d.DisplayBytesDirty(ctx, frame, 20, 10, 16, 8), test.ShouldBeNil)
+	test.That(t, pageOrder(bus), test.ShouldResemble, []int{2, 3, 4})
+	for page := 0; page < 16; page++ {
+		want := byte(0)
+		if page >= 2 && page <= 4 {
+			want = 0xFF
+		}
+		test.That(t, d.current[page*64:(page+1)*64], test.ShouldResemble, bytes.Repeat([]byte{want}, 64))
+	}
+}
+
+func TestPageOrderReverse(t *testing.T) {
+	ctx := context.Background()
+	d, bus := newTestDisplay(t, &Config{PageOrder: pageOrderReverse}, false)
+	frame := make([]byte, len(d.current))
+	for i := range frame {
+		frame[i] = byte(i/64) + 1
+	}
+	test.That(t, d.DisplayBytes(ctx, frame), test.ShouldBeNil)
+	test.That(t, pageOrder(bus), test.ShouldResemble,
+		[]int{15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0})
+	// the order changes, not which data goes to which page
+	for page, data := range sentPages(bus) {
+		test.That(t, data, test.ShouldResemble, bytes.Repeat([]byte{byte(page) + 1}, 64))
+	}
+
+	bus.ClearTransfers()
+	frame = bytes.Repeat([]byte{0xFF}, len(d.current))
+	test.That(t, d.DisplayBytesDirty(ctx, frame, 20, 0, 16, 8), test.ShouldBeNil)
+	test.That(t, pageOrder(bus), test.ShouldResemble, []int{4, 3, 2})
+}
+
+func TestReinitLogRateLimit(t *testing.T) {
+	ctx := context.Background()
+	d, bus := newTestDisplay(t, &Config{}, false)
+	logger, logs := logging.NewObservedTestLogger(t)
+	d.logger = logger
+
+	// a controller that keeps resetting reads as off with its ID on every flush
+	bus.SetReadData([]byte{statusDisplayOff | idSH1107})
+	frame := make([]byte, len(d.current))
+	for i := 0; i < 5; i++ {
+		frame = append([]byte(nil), frame...)
+		frame[0] = byte(i + 1)
+		test.That(t, d.DisplayBytes(ctx, frame), test.ShouldBeNil)
+	}
+	reinits := logs.FilterMessageSnippet("reinitializing")
+	test.That(t, reinits.Len(), test.ShouldEqual, 1)
+
+	// once the interval is up, the next reinit is logged with a count of the ones held back
+	d.lastReinitLog = d.lastReinitLog.Add(-reinitLogInterval)
+	frame = append([]byte(nil), frame...)
+	frame[0] = 0xFF
+	test.That(t, d.DisplayBytes(ctx, frame), test.ShouldBeNil)
+	reinits = logs.FilterMessageSnippet("reinitializing")
+	test.That(t, reinits.Len(), test.ShouldEqual, 2)
+	test.That(t, reinits.All()[1].Message, test.ShouldContainSubstring, "4 more reinits")
+}
+
+func TestInitSequenceCommand(t *testing.T) {
+	ctx := context.Background()
+	cmd := map[string]interface{}{"init_sequence": []interface{}{"0xAE", "D5", 81.0, 175.0}}
+
+	d, bus := newTestDisplay(t, &Config{}, false)
+	_, err := d.DoCommand(ctx, cmd)
+	test.That(t, err, test.ShouldBeError)
+	test.That(t, err.Error(), test.ShouldContainSubstring, "allow_unsafe_commands")
+	test.That(t, bus.Transfers(), test.ShouldBeEmpty)
+
+	d, bus = newTestDisplay(t, &Config{AllowUnsafeCommands: true}, false)
+	result, err := d.DoCommand(ctx, cmd)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, result["init_sequence"], test.ShouldEqual, 4)
+	test.That(t, transferData(bus), test.ShouldResemble, [][]byte{{ctrlCommand, 0xAE, 0xD5, 81, 0xAF}})
+}
+
+func TestRefresh(t *testing.T) {
+	ctx := context.Background()
+	d, bus := newTestDisplay(t, &Config{}, false)
+	test.That(t, d.WriteString(ctx, 10, 30, "refresh"), test.ShouldBeNil)
+	frame := d.current
+	bus.ClearTransfers()
+
+	// nothing has changed, but every page is sent again as it is
+	test.That(t, d.Refresh(ctx), test.ShouldBeNil)
+	test.That(t, pageOrder(bus), test.ShouldResemble, pageRange(0, 15))
+	for page, data := range sentPages(bus) {
+		test.That(t, data, test.ShouldResemble, frame[page*64:(page+1)*64])
+	}
+	test.That(t, d.current, test.ShouldResemble, frame)
+}
+
+func TestReadStatus(t *testing.T) {
+	ctx := context.Background()
+	d, bus := newTestDisplay(t, &Config{}, false)
+
+	// a reset SH1106: not busy, panel off, ID 8
+	bus.SetReadData([]byte{0x48})
+	status, err := d.ReadStatus(ctx)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, status, test.ShouldEqual, 0x48)
+	test.That(t, status&statusDisplayOff, test.ShouldNotEqual, 0)
+	test.That(t, status&statusBusy, test.ShouldEqual, 0)
+	controller, err := d.DetectController(ctx)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, controller, test.ShouldEqual, "sh1106")
+
+	// a busy SSD1306 with the panel on
+	bus.SetReadData([]byte{0x86})
+	controller, err = d.DetectController(ctx)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, controller, test.ShouldEqual, "ssd1306")
+
+	bus.SetReadData([]byte{0x3A})
+	_, err = d.DetectController(ctx)
+	test.That(t, err, test.ShouldBeError)
+	test.That(t, err.Error(), test.ShouldContainSubstring, "0x3A")
+}
+
+func TestHealthcheck(t *testing.T) {
+	ctx := context.Background()
+	d, bus := newTestDisplay(t, &Config{}, false)
+	cmd := map[string]interface{}{"healthcheck": true}
+
+	result, err := d.DoCommand(ctx, cmd)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, result["healthy"], test.ShouldBeTrue)
+	test.That(t, result["error"], test.ShouldBeNil)
+	test.That(t, result["last_flush"], test.ShouldEqual, "")
+
+	test.That(t, d.DrawPixel(ctx, 5, 5), test.ShouldBeNil)
+	result, err = d.DoCommand(ctx, cmd)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, result["healthy"], test.ShouldBeTrue)
+	_, err = time.Parse(time.RFC3339, result["last_flush"].(string))
+	test.That(t, err, test.ShouldBeNil)
+
+	bus.SetError(errors.New("no ack"))
+	result, err = d.DoCommand(ctx, cmd)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, result["healthy"], test.ShouldBeFalse)
+	test.That(t, result["error"], test.ShouldContainSubstring, "no ack")
+	bus.SetError(nil)
+}
+
+func TestSelfCheck(t *testing.T) {
+	d, bus := newTestDisplay(t, &Config{}, false)
+	logger, logs := logging.NewObservedTestLogger(t)
+	d.logger = logger
+
+	// cut the check short once the result is up rather than waiting out how long it stays
+	ctx, cancel := context.WithTimeout(context.Background(), selfCheckPatternHold+100*time.Millisecond)
+	defer cancel()
+	d.selfCheck(ctx)
+
+	// the all-on pattern, the result, then the cleared screen
+	frames := sentFrames(bus)
+	test.That(t, frames, test.ShouldHaveLength, 3)
+	test.That(t, frames[0], test.ShouldResemble, bytes.Repeat([]byte{0xFF}, len(d.current)))
+	g := d.geom
+	test.That(t, frames[1], test.ShouldResemble, g.WriteString((g.Width-g.textWidth("OK"))/2, 20, "OK", g.Blank()))
+	test.That(t, frames[2], test.ShouldResemble, g.Blank())
+	test.That(t, logs.FilterMessage("display self check passed").Len(), test.ShouldEqual, 1)
+}
+
+func TestI2CSpeed(t *testing.T) {
+	// the speed can't be set from here, so asking for one fails validation rather than being quietly ignored
+	_, err := (&Config{I2CBus: "1", I2CSpeedHz: 400000}).Validate("test")
+	test.That(t, err, test.ShouldBeError)
+	test.That(t, err.Error(), test.ShouldContainSubstring, "i2c_arm_baudrate=400000")
+
+	// the device tree stores the clock as a big endian 32 bit cell
+	adapters := t.TempDir()
+	node := filepath.Join(adapters, "i2c-1", "of_node")
+	test.That(t, os.MkdirAll(node, 0o755), test.ShouldBeNil)
+	test.That(t, os.WriteFile(filepath.Join(node, "clock-frequency"), []byte{0x00, 0x06, 0x1A, 0x80}, 0o644),
+		test.ShouldBeNil)
+	old := i2cAdapters
+	i2cAdapters = adapters
+	t.Cleanup(func() { i2cAdapters = old })
+
+	d, _ := newTestDisplay(t, &Config{I2CBus: "1"}, false)
+	result, err := d.DoCommand(context.Background(), map[string]interface{}{"get": "i2c_speed"})
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, result["i2c_speed_hz"], test.ShouldEqual, 400000)
+
+	d, _ = newTestDisplay(t, &Config{I2CBus: "3"}, false)
+	_, err = d.DoCommand(context.Background(), map[string]interface{}{"get": "i2c_speed"})
+	test.That(t, err, test.ShouldBeError)
+}
+
+// slowBus is a fakei2c.Bus that takes delay to address each page.
+type slowBus struct {
+	*fakei2c.Bus
+	delay time.Duration
+}
+
+func (b *slowBus) OpenHandle(addr byte) (buses.I2CHandle, error) {
+	handle, err := b.Bus.OpenHandle(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &slowHandle{I2CHandle: handle, delay: b.delay}, nil
+}
+
+type slowHandle struct {
+	buses.I2CHandle
+	delay time.Duration
+}
+
+func (h *slowHandle) Write(ctx context.Context, tx []byte) error {
+	if len(tx) > 1 && tx[0] == ctrlCommand && tx[1]&0xF0 == sh110xSETPAGEADDR {
+		time.Sleep(h.delay)
+	}
+	return h.I2CHandle.Write(ctx, tx)
+}
+
+func TestFlushTiming(t *testing.T) {
+	ctx := context.Background()
+	d, bus := newTestDisplay(t, &Config{}, false)
+	get := map[string]interface{}{"get": "flush_timing"}
+	result, err := d.DoCommand(ctx, get)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, result, test.ShouldResemble, map[string]interface{}{"samples": 0, "min_ms": 0.0, "avg_ms": 0.0, "max_ms": 0.0})
+
+	d.bus = &slowBus{Bus: bus, delay: time.Millisecond}
+	// three whole frames, 16 pages each, then two frames that change a single page
+	for i := 0; i < 3; i++ {
+		test.That(t, d.DisplayBytes(ctx, bytes.Repeat([]byte{byte(i + 1)}, len(d.current))), test.ShouldBeNil)
+	}
+	test.That(t, d.DrawPixel(ctx, 5, 0), test.ShouldBeNil)
+	test.That(t, d.ClearPixel(ctx, 5, 0), test.ShouldBeNil)
+
+	result, err = d.DoCommand(ctx, get)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, result["samples"], test.ShouldEqual, 5)
+	lo, avg, hi := result["min_ms"].(float64), result["avg_ms"].(float64), result["max_ms"].(float64)
+	test.That(t, lo, test.ShouldBeGreaterThanOrEqualTo, 1)
+	test.That(t, hi, test.ShouldBeGreaterThanOrEqualTo, 16)
+	test.That(t, avg, test.ShouldBeGreaterThanOrEqualTo, float64(3*16+2)/5)
+	test.That(t, avg, test.ShouldBeBetweenOrEqual, lo, hi)
+	// the single page frames are the quickest
+	test.That(t, lo, test.ShouldBeLessThan, hi)
+}
+
+func TestChangedBounds(t *testing.T) {
+	ctx := context.Background()
+	d, _ := newTestDisplay(t, &Config{}, false)
+	get := map[string]interface{}{"get": "changed_bounds"}
+
+	// drawn right to left and top to bottom, the box is still the line's extent
+	test.That(t, d.DrawLine(ctx, 50, 40, 10, 20), test.ShouldBeNil)
+	result, err := d.DoCommand(ctx, get)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, result, test.ShouldResemble, map[string]interface{}{"x": 10, "y": 20, "w": 41, "h": 21})
+
+	// a second line only counts its own pixels, not the first's
+	test.That(t, d.DrawLine(ctx, 60, 5, 60, 9), test.ShouldBeNil)
+	result, err = d.DoCommand(ctx, get)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, result, test.ShouldResemble, map[string]interface{}{"x": 60, "y": 5, "w": 1, "h": 5})
+
+	// redrawing what's already there changes nothing
+	test.That(t, d.DrawLine(ctx, 60, 5, 60, 9), test.ShouldBeNil)
+	result, err = d.DoCommand(ctx, get)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, result, test.ShouldResemble, map[string]interface{}{"x": 0, "y": 0, "w": 0, "h": 0})
+}
+
+func TestLogLineWraps(t *testing.T) {
+	ctx := context.Background()
+	d, _ := newTestDisplay(t, &Config{}, false)
+	g := d.geom
+	// too wide for one row, so it's split at the space across two
+	test.That(t, g.textWidth("hello world"), test.ShouldBeGreaterThan, g.Width)
+	test.That(t, d.LogLine(ctx, "hello world", false), test.ShouldBeNil)
+	test.That(t, d.console, test.ShouldResemble, []string{"hello", "world"})
+
+	top := g.Height - 1 - g.font().ascent()
+	want := g.WriteString(0, top, "hello", g.Blank())
+	want = g.WriteString(0, top-g.lineHeight(), "world", want)
+	test.That(t, d.current, test.ShouldResemble, want)
+}
+
+func TestScrollTextFrames(t *testing.T) {
+	ctx := context.Background()
+	d, bus := newTestDisplay(t, &Config{}, false)
+	g := d.geom
+	sprite, w, h := g.RenderStringSprite("hi")
+	bottom := d.baseline(40) - g.font().descent()
+
+	test.That(t, d.ScrollText(ctx, 40, "hi", 1), test.ShouldBeNil)
+	// one frame for each x from the right edge until the text is off the left
+	frames := sentFrames(bus)
+	test.That(t, frames, test.ShouldHaveLength, g.Width+w+1)
+	for i, frame := range frames {
+		test.That(t, frame, test.ShouldResemble, g.WriteSprite(g.Width-i, bottom, w, h, sprite, g.Blank()))
+		if i == 0 {
+			continue
+		}
+		// and each is the one before moved a pixel left
+		shifted := true
+		for x := 0; x < g.Width-1; x++ {
+			for y := bottom; y < bottom+h; y++ {
+				shifted = shifted && g.Pixel(x, y, frame) == g.Pixel(x+1, y, frames[i-1])
+			}
+		}
+		test.That(t, shifted, test.ShouldBeTrue)
+	}
+}
+
+func TestRegionRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	d, bus := newTestDisplay(t, &Config{}, false)
+	test.That(t, d.WriteString(ctx, 20, 40, "ab"), test.ShouldBeNil)
+	drawn := d.current
+	// the region is the text's bounding box, plus a pixel of margin
+	box := d.changed.Inset(-1)
+
+	region, err := d.GetRegion(ctx, box.Min.X, box.Min.Y, box.Dx(), box.Dy())
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, region, test.ShouldHaveLength, len(NewBuffer(box.Dx(), box.Dy())))
+	test.That(t, d.Clear(ctx), test.ShouldBeNil)
+
+	bus.ClearTransfers()
+	test.That(t, d.SetRegion(ctx, box.Min.X, box.Min.Y, box.Dx(), box.Dy(), region), test.ShouldBeNil)
+	test.That(t, d.current, test.ShouldResemble, drawn)
+	test.That(t, pageOrder(bus), test.ShouldResemble, pageRange(box.Min.X/8, (box.Max.X-1)/8))
+
+	test.That(t, d.SetRegion(ctx, box.Min.X, box.Min.Y, box.Dx(), box.Dy(), region[1:]), test.ShouldBeError)
+}
+
+func TestWriteStringDouble(t *testing.T) {
+	ctx := context.Background()
+	// with and without smoothing, which WriteStringScaled switches to from 2x up
+	for _, smooth := range []bool{false, true} {
+		d, _ := newTestDisplay(t, &Config{SmoothText: smooth}, false)
+		test.That(t, d.WriteStringDouble(ctx, 4, 30, "Hi2"), test.ShouldBeNil)
+		double := d.current
+		test.That(t, double, test.ShouldNotResemble, d.geom.Blank())
+
+		test.That(t, d.Clear(ctx), test.ShouldBeNil)
+		test.That(t, d.WriteStringScaled(ctx, 4, 30, 2, "Hi2"), test.ShouldBeNil)
+		test.That(t, d.current, test.ShouldResemble, double)
+	}
+}
+
+func TestTextAnchor(t *testing.T) {
+	ctx := context.Background()
+	bounds := map[string]image.Rectangle{}
+	for _, anchor := range []string{anchorBaseline, anchorTop} {
+		d, _ := newTestDisplay(t, &Config{TextAnchor: anchor}, false)
+		test.That(t, d.WriteString(ctx, 10, 36, "Hg"), test.ShouldBeNil)
+		bounds[anchor] = d.changed
+	}
+	// the same text, moved down by the font's ascent so that y is the top of the line rather than its baseline
+	ascent := freeMonoBold18.ascent()
+	test.That(t, bounds[anchorTop], test.ShouldResemble, bounds[anchorBaseline].Sub(image.Pt(0, ascent)))
+	test.That(t, bounds[anchorTop].Max.Y, test.ShouldBeLessThanOrEqualTo, 37)
+	// the g hangs below the baseline and the H stands on it, all of it on screen
+	test.That(t, bounds[anchorBaseline].Min.Y, test.ShouldBeLessThan, 36)
+	test.That(t, bounds[anchorBaseline].Max.Y, test.ShouldBeGreaterThan, 37)
+	test.That(t, bounds[anchorBaseline].Max.Y, test.ShouldBeLessThan, 64)
+	test.That(t, bounds[anchorTop].Min.Y, test.ShouldBeGreaterThan, 0)
+}
+
+func TestClearPatternOn(t *testing.T) {
+	ctx := context.Background()
+	d, bus := newTestDisplay(t, &Config{ClearPattern: "on"}, false)
+	allOn := bytes.Repeat([]byte{0xFF}, len(d.current))
+	// the screen starts out cleared too
+	test.That(t, d.current, test.ShouldResemble, allOn)
+	test.That(t, d.ClearPixel(ctx, 10, 30), test.ShouldBeNil)
+	bus.ClearTransfers()
+
+	test.That(t, d.Clear(ctx), test.ShouldBeNil)
+	test.That(t, d.current, test.ShouldResemble, allOn)
+	test.That(t, pageOrder(bus), test.ShouldResemble, []int{1})
+	for _, data := range sentPages(bus) {
+		test.That(t, data, test.ShouldResemble, bytes.Repeat([]byte{0xFF}, 64))
+	}
+
+	test.That(t, d.ClearPixel(ctx, 10, 30), test.ShouldBeNil)
+	test.That(t, d.Reset(ctx), test.ShouldBeNil)
+	test.That(t, d.current, test.ShouldResemble, allOn)
+}
+
+func TestSetScaleLine(t *testing.T) {
+	ctx := context.Background()
+	d, _ := newTestDisplay(t, &Config{}, false)
+	g := d.geom
+	test.That(t, d.DrawLine(ctx, 2, 3, 20, 3), test.ShouldBeNil)
+	litOnly(t, g, d.current, 0, 0, g.Width, g.Height, func(px, py int) bool { return px >= 2 && px <= 20 && py == 3 })
+
+	// at scale 2 the 19 pixel line is 38 long, and each of its pixels is a 2x2 block
+	test.That(t, d.Clear(ctx), test.ShouldBeNil)
+	test.That(t, d.SetScale(ctx, 2), test.ShouldBeNil)
+	test.That(t, d.DrawLine(ctx, 2, 3, 20, 3), test.ShouldBeNil)
+	litOnly(t, g, d.current, 0, 0, g.Width, g.Height, func(px, py int) bool {
+		return px >= 4 && px <= 41 && (py == 6 || py == 7)
+	})
+	test.That(t, d.changed.Dx(), test.ShouldEqual, 2*19)
+
+	// and so is a vertical one
+	test.That(t, d.Clear(ctx), test.ShouldBeNil)
+	test.That(t, d.DrawLine(ctx, 5, 1, 5, 10), test.ShouldBeNil)
+	test.That(t, d.changed, test.ShouldResemble, image.Rect(10, 2, 12, 22))
+}
+
+func TestWriteStringCursor(t *testing.T) {
+	ctx := context.Background()
+	d, _ := newTestDisplay(t, &Config{}, false)
+	w, _, err := d.MeasureString(ctx, "ab")
+	test.That(t, err, test.ShouldBeNil)
+	x, y, err := d.WriteStringCursor(ctx, 5, 30, "ab")
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, x, test.ShouldEqual, 5+w)
+	test.That(t, y, test.ShouldEqual, 30)
+
+	// picking up from the cursor gives the same frame as writing it all at once
+	_, _, err = d.WriteStringCursor(ctx, x, y, "cd")
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, d.current, test.ShouldResemble, d.geom.WriteString(5, 30, "abcd", d.geom.Blank()))
+}
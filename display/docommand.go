@@ -0,0 +1,349 @@
+package display
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.viam.com/rdk/resource"
+	"go.viam.com/utils"
+)
+
+// DoCommand handles the display's extra commands that don't warrant their own RPC.
+//
+//	{"init_sequence": ["0xAE", "0xD5", 81, ...]} sends the given bytes as a command transfer (unsafe)
+//	{"get": "i2c_speed"}                         reports the bus clock the kernel configured, in Hz
+//	{"get": "flush_timing"}                      reports min/avg/max milliseconds spent sending recent frames
+//	{"get": "changed_bounds"}                    reports the rectangle of pixels the last draw changed
+//	{"get": "clipped_pixels"}                    reports how many pixels the last draw dropped off the screen
+//	{"get": "animating"}                         reports whether an animation is running, and which kind
+//	{"register_font": "name", "bdf": "..."}      registers a BDF font for SetFont
+//	{"register_gfx_font": "name", ...}           registers an Adafruit GFX font for SetFont
+//	{"font_spacing": "name", "tracking": 1}      changes the spacing of a registered font
+//	{"healthcheck": true}                        probes the bus and reports whether the display answered
+//	{"skip_animation": true}                     leaves the startup animation out when the display is rebuilt
+func (d *display) DoCommand(ctx context.Context, cmd map[string]interface{}) (map[string]interface{}, error) {
+	if raw, ok := cmd["init_sequence"]; ok {
+		return d.doInitSequence(ctx, raw)
+	}
+	if skip, ok := cmd["skip_animation"]; ok {
+		return d.doSkipAnimation(skip)
+	}
+	if _, ok := cmd["healthcheck"]; ok {
+		return d.healthcheck(ctx), nil
+	}
+	if name, ok := cmd["font_spacing"]; ok {
+		return d.doFontSpacing(name, cmd["tracking"], cmd["kerning"])
+	}
+	if name, ok := cmd["register_font"]; ok {
+		return doRegisterFont(name, cmd["bdf"])
+	}
+	if name, ok := cmd["register_gfx_font"]; ok {
+		return doRegisterGFXFont(name, cmd["bitmap"], cmd["glyphs"], cmd["first"])
+	}
+	if what, ok := cmd["get"]; ok {
+		switch what {
+		case "i2c_speed":
+			hz, err := i2cSpeed(d.busName)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{"i2c_speed_hz": hz}, nil
+		case "flush_timing":
+			return d.flushTiming(), nil
+		case "changed_bounds":
+			return d.changedBounds(), nil
+		case "clipped_pixels":
+			return d.clippedPixels()
+		case "animating":
+			return d.animating(), nil
+		default:
+			return nil, fmt.Errorf("unknown get %q", what)
+		}
+	}
+	return nil, resource.ErrDoUnimplemented
+}
+
+// doFontSpacing re-registers the named font with the given tracking and kerning, replacing any it had before. Either
+// may be left out for none. If this display is writing in the font, its text is spaced the new way from the next draw.
+func (d *display) doFontSpacing(name, tracking, kerning interface{}) (map[string]interface{}, error) {
+	fontName, ok := name.(string)
+	if !ok {
+		return nil, fmt.Errorf("font_spacing: expected a font name, got %T", name)
+	}
+	font, err := lookupFont(fontName)
+	if err != nil {
+		return nil, err
+	}
+	track := 0
+	if tracking != nil {
+		t, ok := tracking.(float64)
+		if !ok || t != math.Trunc(t) {
+			return nil, fmt.Errorf("font_spacing: tracking must be a whole number, got %v", tracking)
+		}
+		track = int(t)
+	}
+	kern := map[string]int{}
+	if kerning != nil {
+		pairs, ok := kerning.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf(`font_spacing: kerning must map pairs of characters to adjustments, like {"AV": -2}`)
+		}
+		for pair, v := range pairs {
+			k, ok := v.(float64)
+			if !ok || k != math.Trunc(k) {
+				return nil, fmt.Errorf("font_spacing: kerning for %q must be a whole number, got %v", pair, v)
+			}
+			kern[pair] = int(k)
+		}
+	}
+	spaced, err := font.withSpacing(track, kern)
+	if err != nil {
+		return nil, fmt.Errorf("font_spacing: %w", err)
+	}
+	if err := RegisterFont(fontName, spaced); err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	if d.fontName == fontName {
+		d.geom.Font = spaced
+	}
+	d.mu.Unlock()
+	return map[string]interface{}{"font_spacing": fontName}, nil
+}
+
+// healthcheck probes the controller and reports the result with when a frame was last sent successfully, for
+// monitoring to poll. A failed probe is reported in the result rather than as an error, so monitors get the flush time
+// either way.
+func (d *display) healthcheck(ctx context.Context) map[string]interface{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	result := map[string]interface{}{"healthy": true, "last_flush": ""}
+	if !d.lastFlush.IsZero() {
+		result["last_flush"] = d.lastFlush.Format(time.RFC3339)
+	}
+	if err := d.probe(ctx); err != nil {
+		result["healthy"] = false
+		result["error"] = err.Error()
+	}
+	return result
+}
+
+// doSkipAnimation sets whether this display leaves out the startup animation from its next rebuild on, on top of the
+// skip_animation attribute.
+func (d *display) doSkipAnimation(skip interface{}) (map[string]interface{}, error) {
+	on, ok := skip.(bool)
+	if !ok {
+		return nil, fmt.Errorf("skip_animation: expected true or false, got %T", skip)
+	}
+	skipAnimationMu.Lock()
+	defer skipAnimationMu.Unlock()
+	skipAnimation[d.Name()] = on
+	return map[string]interface{}{"skip_animation": on}, nil
+}
+
+// doRegisterFont parses the BDF font source in bdf and registers it as name.
+func doRegisterFont(name, bdf interface{}) (map[string]interface{}, error) {
+	fontName, ok := name.(string)
+	if !ok {
+		return nil, fmt.Errorf("register_font: expected a font name, got %T", name)
+	}
+	src, ok := bdf.(string)
+	if !ok {
+		return nil, fmt.Errorf("register_font: expected the font's BDF source in bdf, got %T", bdf)
+	}
+	font, err := ParseBDF(strings.NewReader(src))
+	if err != nil {
+		return nil, fmt.Errorf("register_font: %w", err)
+	}
+	if err := RegisterFont(fontName, font); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"register_font": fontName}, nil
+}
+
+// doRegisterGFXFont registers a font given as the arrays of an Adafruit GFX font header: the bitmap bytes, and one
+// [offset, width, height, x advance, x offset, y offset] list per glyph starting at the character first, 0x20 if
+// left out.
+func doRegisterGFXFont(name, bitmap, glyphs, first interface{}) (map[string]interface{}, error) {
+	fontName, ok := name.(string)
+	if !ok {
+		return nil, fmt.Errorf("register_gfx_font: expected a font name, got %T", name)
+	}
+	font := &Font{First: 0x20}
+	var err error
+	if font.Bitmap, err = parseByteList(bitmap); err != nil {
+		return nil, fmt.Errorf("register_gfx_font: bitmap: %w", err)
+	}
+	list, ok := glyphs.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("register_gfx_font: expected a list of glyphs, got %T", glyphs)
+	}
+	for i, g := range list {
+		metrics, ok := g.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("register_gfx_font: glyph %d: expected a list of numbers, got %T", i, g)
+		}
+		glyph := make([]int, len(metrics))
+		for j, m := range metrics {
+			v, ok := m.(float64)
+			if !ok || v != math.Trunc(v) {
+				return nil, fmt.Errorf("register_gfx_font: glyph %d: %v is not a whole number", i, m)
+			}
+			glyph[j] = int(v)
+		}
+		font.Glyphs = append(font.Glyphs, glyph)
+	}
+	if first != nil {
+		f, ok := first.(float64)
+		if !ok || f != math.Trunc(f) || f < 0 || f > 255 {
+			return nil, fmt.Errorf("register_gfx_font: first must be a character code from 0 to 255, got %v", first)
+		}
+		font.First = byte(f)
+	}
+	if err := RegisterFont(fontName, font); err != nil {
+		return nil, fmt.Errorf("register_gfx_font: %w", err)
+	}
+	return map[string]interface{}{"register_gfx_font": fontName}, nil
+}
+
+// flushTiming summarizes how long the most recent frames took to send, to tell a slow bus from an update loop that
+// is simply flushing too often.
+func (d *display) flushTiming() map[string]interface{} {
+	d.mu.Lock()
+	lo, avg, hi := d.timing.stats()
+	count := d.timing.count
+	d.mu.Unlock()
+	ms := func(dur time.Duration) float64 { return float64(dur) / float64(time.Millisecond) }
+	return map[string]interface{}{
+		"samples": count,
+		"min_ms":  ms(lo),
+		"avg_ms":  ms(avg),
+		"max_ms":  ms(hi),
+	}
+}
+
+// changedBounds reports the bounding box of the pixels the last draw turned on or off, so a UI drawing in layers knows
+// how much of what's under it to redraw.
+func (d *display) changedBounds() map[string]interface{} {
+	d.mu.Lock()
+	changed := d.changed
+	d.mu.Unlock()
+	return map[string]interface{}{
+		"x": changed.Min.X,
+		"y": changed.Min.Y,
+		"w": changed.Dx(),
+		"h": changed.Dy(),
+	}
+}
+
+// Kinds of animation the animating DoCommand reports.
+const (
+	animationScroll         = "scroll"          // ScrollText is moving text across the screen
+	animationHardwareScroll = "hardware_scroll" // StartScroll has the controller scrolling
+	animationSplash         = "splash"          // the boot sequence's splash is up
+)
+
+// animating reports whether something is animating the screen, which a draw would cut across or stop, and what. The
+// kind is empty when nothing is.
+func (d *display) animating() map[string]interface{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	kind := ""
+	switch {
+	case d.marquees > 0:
+		kind = animationScroll
+	case d.scrolling:
+		kind = animationHardwareScroll
+	case d.splashUp:
+		kind = animationSplash
+	}
+	return map[string]interface{}{"animating": kind != "", "kind": kind}
+}
+
+// clippedPixels reports how many pixels the last draw dropped off the screen, which is only counted when
+// report_clipped is set.
+func (d *display) clippedPixels() (map[string]interface{}, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.reportClipped {
+		return nil, fmt.Errorf("clipped_pixels needs the report_clipped attribute set")
+	}
+	return map[string]interface{}{"clipped_pixels": d.lastClipped}, nil
+}
+
+// i2cAdapters is where sysfs lists the I2C buses, a variable so tests can stand in their own.
+var i2cAdapters = "/sys/class/i2c-adapter"
+
+// i2cSpeed reads the clock frequency the device tree set for the numbered I2C bus. The speed can't be changed from
+// userspace, so this is read only.
+func i2cSpeed(bus string) (int, error) {
+	bus = strings.TrimPrefix(bus, "/dev/i2c-")
+	raw, err := os.ReadFile(filepath.Join(i2cAdapters, "i2c-"+bus, "of_node", "clock-frequency"))
+	if err != nil {
+		return 0, fmt.Errorf("i2c bus %s does not report its speed: %w", bus, err)
+	}
+	if len(raw) != 4 {
+		return 0, fmt.Errorf("i2c bus %s reported a malformed clock-frequency", bus)
+	}
+	return int(binary.BigEndian.Uint32(raw)), nil
+}
+
+// doInitSequence writes a caller supplied command sequence straight to the controller, so init tweaks can be tried
+// without restarting the module. A bad sequence can leave the panel in any state, so it needs allow_unsafe_commands.
+func (d *display) doInitSequence(ctx context.Context, raw interface{}) (map[string]interface{}, error) {
+	if !d.allowUnsafe {
+		return nil, fmt.Errorf("init_sequence requires allow_unsafe_commands to be set in the config")
+	}
+	seq, err := parseByteList(raw)
+	if err != nil {
+		return nil, fmt.Errorf("init_sequence: %w", err)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	handle, err := d.bus.OpenHandle(d.addr)
+	if err != nil {
+		return nil, err
+	}
+	defer utils.UncheckedErrorFunc(handle.Close)
+	if err := handle.Write(ctx, d.command(seq...)); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"init_sequence": len(seq)}, nil
+}
+
+// parseByteList converts a DoCommand list of numbers or hex strings ("0xAE" or "AE") into bytes.
+func parseByteList(raw interface{}) ([]byte, error) {
+	list, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a list of bytes, got %T", raw)
+	}
+	out := make([]byte, 0, len(list))
+	for i, v := range list {
+		switch val := v.(type) {
+		case float64:
+			if val != math.Trunc(val) || val < 0 || val > 255 {
+				return nil, fmt.Errorf("element %d: %v is not a byte", i, val)
+			}
+			out = append(out, byte(val))
+		case string:
+			b, err := strconv.ParseUint(val, 0, 8)
+			if err != nil {
+				b, err = strconv.ParseUint(val, 16, 8)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %q is not a byte", i, val)
+			}
+			out = append(out, byte(b))
+		default:
+			return nil, fmt.Errorf("element %d: expected a number or hex string, got %T", i, v)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,131 @@
+// Package fakei2c is an in-memory I2C bus for exercising the display without hardware. It records every transfer
+// written to it and answers reads with data the caller sets up.
+package fakei2c
+
+import (
+	"context"
+	"sync"
+
+	"go.viam.com/rdk/components/board/genericlinux/buses"
+)
+
+// Transfer is one write to the bus: the address it went to and the bytes sent.
+type Transfer struct {
+	Addr byte
+	Data []byte
+}
+
+// Bus is a buses.I2C that keeps what's written to it. Reads return the bytes set with SetReadData, and once SetError
+// is called every operation fails with that error. It's safe for concurrent use.
+type Bus struct {
+	mu        sync.Mutex
+	transfers []Transfer
+	readData  []byte
+	err       error
+}
+
+var _ buses.I2C = (*Bus)(nil)
+
+// NewBus returns an empty bus whose reads return zeros.
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+// OpenHandle returns a handle on the device at addr. Every address answers.
+func (b *Bus) OpenHandle(addr byte) (buses.I2CHandle, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.err != nil {
+		return nil, b.err
+	}
+	return &handle{bus: b, addr: addr}, nil
+}
+
+// Transfers returns a copy of everything written to the bus so far, oldest first.
+func (b *Bus) Transfers() []Transfer {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	transfers := make([]Transfer, len(b.transfers))
+	for i, t := range b.transfers {
+		transfers[i] = Transfer{Addr: t.Addr, Data: append([]byte(nil), t.Data...)}
+	}
+	return transfers
+}
+
+// ClearTransfers forgets what's been written so far, e.g. to look at one draw's traffic without the init sequence.
+func (b *Bus) ClearTransfers() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.transfers = nil
+}
+
+// SetReadData sets what reads return: a read of n bytes gets the first n of data, padded with zeros if it's short.
+// The display's status read takes the first byte.
+func (b *Bus) SetReadData(data []byte) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.readData = append([]byte(nil), data...)
+}
+
+// SetError makes every later operation on the bus fail with err, as an unplugged device would. nil puts it back.
+func (b *Bus) SetError(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.err = err
+}
+
+type handle struct {
+	bus  *Bus
+	addr byte
+}
+
+func (h *handle) Write(ctx context.Context, tx []byte) error {
+	h.bus.mu.Lock()
+	defer h.bus.mu.Unlock()
+	if h.bus.err != nil {
+		return h.bus.err
+	}
+	h.bus.transfers = append(h.bus.transfers, Transfer{Addr: h.addr, Data: append([]byte(nil), tx...)})
+	return nil
+}
+
+func (h *handle) Read(ctx context.Context, count int) ([]byte, error) {
+	h.bus.mu.Lock()
+	defer h.bus.mu.Unlock()
+	if h.bus.err != nil {
+		return nil, h.bus.err
+	}
+	data := make([]byte, count)
+	copy(data, h.bus.readData)
+	return data, nil
+}
+
+func (h *handle) ReadByteData(ctx context.Context, register byte) (byte, error) {
+	if err := h.Write(ctx, []byte{register}); err != nil {
+		return 0, err
+	}
+	data, err := h.Read(ctx, 1)
+	if err != nil {
+		return 0, err
+	}
+	return data[0], nil
+}
+
+func (h *handle) WriteByteData(ctx context.Context, register, data byte) error {
+	return h.Write(ctx, []byte{register, data})
+}
+
+func (h *handle) ReadBlockData(ctx context.Context, register byte, numBytes uint8) ([]byte, error) {
+	if err := h.Write(ctx, []byte{register}); err != nil {
+		return nil, err
+	}
+	return h.Read(ctx, int(numBytes))
+}
+
+func (h *handle) WriteBlockData(ctx context.Context, register byte, data []byte) error {
+	return h.Write(ctx, append([]byte{register}, data...))
+}
+
+func (h *handle) Close() error {
+	return nil
+}
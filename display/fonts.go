@@ -0,0 +1,178 @@
+package display
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Font is a bitmap font in the Adafruit GFX layout, the format of the built in font. Glyphs covers the characters
+// from First up, one {bitmap offset, width, height, x advance, x offset, y offset} entry each. A glyph's pixels are
+// packed row by row from its bitmap offset, most significant bit first, and its y offset is from the baseline to its
+// top row, negative upwards.
+//
+// Tracking is added to every glyph's advance, and Kerning adds more between specific pairs of characters, keyed by
+// the two characters ("AV"). Both default to zero, which spaces text exactly as the glyph metrics say.
+type Font struct {
+	Bitmap   []byte
+	Glyphs   [][]int
+	First    byte
+	Tracking int
+	Kerning  map[string]int
+}
+
+// defaultFont is the FreeMono Bold 18pt font the text methods use until a display is switched to another.
+const defaultFont = "freemono-bold-18"
+
+var freeMonoBold18 = &Font{Bitmap: freemono, Glyphs: chars, First: 0x20}
+
+// The font registry. Fonts are shared by every display in the module, so it's guarded for concurrent use.
+var (
+	fontsMu sync.RWMutex
+	fonts   = map[string]*Font{defaultFont: freeMonoBold18}
+)
+
+// RegisterFont makes font available to SetFont under name, replacing any font already registered with it.
+func RegisterFont(name string, font *Font) error {
+	if name == "" {
+		return fmt.Errorf("font name must not be empty")
+	}
+	if err := font.validate(); err != nil {
+		return fmt.Errorf("font %q: %w", name, err)
+	}
+	fontsMu.Lock()
+	defer fontsMu.Unlock()
+	fonts[name] = font
+	return nil
+}
+
+func lookupFont(name string) (*Font, error) {
+	fontsMu.RLock()
+	defer fontsMu.RUnlock()
+	font, ok := fonts[name]
+	if !ok {
+		return nil, fmt.Errorf("no font registered as %q", name)
+	}
+	return font, nil
+}
+
+// validate checks every glyph's bitmap lies within the font's bitmap, so drawing can't index past it.
+func (f *Font) validate() error {
+	if len(f.Glyphs) == 0 {
+		return fmt.Errorf("has no glyphs")
+	}
+	if int(f.First)+len(f.Glyphs) > 256 {
+		return fmt.Errorf("%d glyphs starting at 0x%02X run past 0xFF", len(f.Glyphs), f.First)
+	}
+	for i, g := range f.Glyphs {
+		if len(g) != 6 {
+			return fmt.Errorf("glyph %d has %d metrics, expected 6", i, len(g))
+		}
+		if g[0] < 0 || g[1] < 0 || g[2] < 0 {
+			return fmt.Errorf("glyph %d has a negative offset or size", i)
+		}
+		if end := g[0] + (g[1]*g[2]+7)/8; end > len(f.Bitmap) {
+			return fmt.Errorf("glyph %d needs bitmap bytes up to %d, the bitmap has %d", i, end, len(f.Bitmap))
+		}
+	}
+	return nil
+}
+
+// glyph returns the metrics of character c, or false if the font doesn't cover it.
+func (f *Font) glyph(c byte) ([]int, bool) {
+	if c < f.First || int(c-f.First) >= len(f.Glyphs) {
+		return nil, false
+	}
+	return f.Glyphs[c-f.First], true
+}
+
+// forEachPixel calls fn with the offset from the text's start point of every lit pixel of text. Characters the font
+// doesn't cover are skipped.
+func (f *Font) forEachPixel(text string, fn func(dx, dy int)) {
+	x := 0
+	for i, cb := range []byte(text) {
+		cInfo, ok := f.glyph(cb)
+		if !ok {
+			continue
+		}
+		// byte offset
+		bo := cInfo[0]
+		w := cInfo[1]
+		h := cInfo[2]
+		adv := f.advance(text, i)
+		xo := cInfo[4]
+		yo := cInfo[5]
+
+		var bit byte
+		var bits byte
+
+		for yy := 0; yy < h; yy++ {
+			for xx := 0; xx < w; xx++ {
+				if bit&7 == 0 {
+					bits = f.Bitmap[bo]
+					bo++
+				}
+				bit++
+				if (bits & 0x80) > 0 {
+					fn(x+xo+xx, -yo-yy)
+				}
+				bits <<= 1
+			}
+		}
+		x += adv
+	}
+}
+
+// width returns how far writing text advances x.
+func (f *Font) width(text string) int {
+	width := 0
+	for i, cb := range []byte(text) {
+		if _, ok := f.glyph(cb); ok {
+			width += f.advance(text, i)
+		}
+	}
+	return width
+}
+
+// advance is how far x moves after drawing text[i], which the font must cover, including the font's spacing.
+func (f *Font) advance(text string, i int) int {
+	adv := f.Glyphs[text[i]-f.First][3] + f.Tracking
+	if i+1 < len(text) {
+		adv += f.Kerning[text[i:i+2]]
+	}
+	return adv
+}
+
+// withSpacing returns a copy of the font with the given spacing, sharing its glyphs.
+func (f *Font) withSpacing(tracking int, kerning map[string]int) (*Font, error) {
+	for pair := range kerning {
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("kerning pairs must be two characters, got %q", pair)
+		}
+	}
+	spaced := *f
+	spaced.Tracking = tracking
+	spaced.Kerning = kerning
+	return &spaced, nil
+}
+
+// ascent is how far the tallest glyph reaches above the baseline, not counting the baseline row.
+func (f *Font) ascent() int {
+	ascent := 0
+	for _, g := range f.Glyphs {
+		if g[2] > 0 && -g[5] > ascent {
+			ascent = -g[5]
+		}
+	}
+	return ascent
+}
+
+// descent is how far the lowest descender hangs below the baseline.
+func (f *Font) descent() int {
+	descent := 0
+	for _, g := range f.Glyphs {
+		if g[2] > 0 && g[5]+g[2]-1 > descent {
+			descent = g[5] + g[2] - 1
+		}
+	}
+	return descent
+}
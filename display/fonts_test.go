@@ -0,0 +1,68 @@
+package display
+
+import (
+	"context"
+	"testing"
+
+	"go.viam.com/test"
+)
+
+func TestFontSpacingAppliesToCurrentFont(t *testing.T) {
+	ctx := context.Background()
+	d, _ := newTestDisplay(t, &Config{}, false)
+	// the registry is shared, so the default font gets its spacing back for the other tests
+	t.Cleanup(func() {
+		_, err := d.DoCommand(ctx, map[string]interface{}{"font_spacing": defaultFont, "tracking": 0.0})
+		test.That(t, err, test.ShouldBeNil)
+	})
+
+	const text = "Hello"
+	endX, _, err := d.WriteStringCursor(ctx, 0, 30, text)
+	test.That(t, err, test.ShouldBeNil)
+
+	// the default font, before any SetFont
+	_, err = d.DoCommand(ctx, map[string]interface{}{"font_spacing": defaultFont, "tracking": 1.0})
+	test.That(t, err, test.ShouldBeNil)
+	spacedX, _, err := d.WriteStringCursor(ctx, 0, 30, text)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, spacedX, test.ShouldEqual, endX+len(text))
+
+	// and a font picked with SetFont, which holds on to the font it was given
+	test.That(t, RegisterFont("spacing-test", freeMonoBold18), test.ShouldBeNil)
+	test.That(t, d.SetFont(ctx, "spacing-test"), test.ShouldBeNil)
+	_, err = d.DoCommand(ctx, map[string]interface{}{"font_spacing": "spacing-test", "tracking": 2.0})
+	test.That(t, err, test.ShouldBeNil)
+	spacedX, _, err = d.WriteStringCursor(ctx, 0, 30, text)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, spacedX, test.ShouldEqual, endX+2*len(text))
+}
+
+func TestRegisteredFontsRenderDifferently(t *testing.T) {
+	ctx := context.Background()
+	// two one glyph fonts whose "A" is a 3x5 block in one and a 1x5 bar in the other, both with their bottom row on the baseline
+	block := &Font{Bitmap: []byte{0xFF, 0xFF}, First: 'A', Glyphs: [][]int{{0, 3, 5, 4, 0, -4}}}
+	bar := &Font{Bitmap: []byte{0xF8}, First: 'A', Glyphs: [][]int{{0, 1, 5, 2, 0, -4}}}
+	test.That(t, RegisterFont("block-test", block), test.ShouldBeNil)
+	test.That(t, RegisterFont("bar-test", bar), test.ShouldBeNil)
+	t.Cleanup(func() {
+		fontsMu.Lock()
+		delete(fonts, "block-test")
+		delete(fonts, "bar-test")
+		fontsMu.Unlock()
+	})
+
+	d, _ := newTestDisplay(t, &Config{}, false)
+	g := d.geom
+	test.That(t, d.SetFont(ctx, "block-test"), test.ShouldBeNil)
+	test.That(t, d.WriteString(ctx, 10, 20, "A"), test.ShouldBeNil)
+	litOnly(t, g, d.current, 0, 0, g.Width, g.Height, func(px, py int) bool {
+		return px >= 10 && px < 13 && py >= 20 && py < 25
+	})
+
+	test.That(t, d.Clear(ctx), test.ShouldBeNil)
+	test.That(t, d.SetFont(ctx, "bar-test"), test.ShouldBeNil)
+	test.That(t, d.WriteString(ctx, 10, 20, "A"), test.ShouldBeNil)
+	litOnly(t, g, d.current, 0, 0, g.Width, g.Height, func(px, py int) bool { return px == 10 && py >= 20 && py < 25 })
+
+	test.That(t, d.SetFont(ctx, "no-such-font"), test.ShouldBeError)
+}
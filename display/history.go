@@ -0,0 +1,43 @@
+package display
+
+// defaultUndoDepth is how many frames Undo can step back through when undo_depth isn't set.
+const defaultUndoDepth = 8
+
+// frameHistory keeps the frames replaced by recent draws for Undo, and the frames Undo stepped back from for Redo.
+// Frame buffers are never modified once they've been sent, so the slices are kept without copying.
+type frameHistory struct {
+	depth int
+	undo  [][]byte
+	redo  [][]byte
+}
+
+// push records the frame a new draw replaced. A new draw starts a new branch, so there's nothing left to redo.
+func (h *frameHistory) push(frame []byte) {
+	h.undo = append(h.undo, frame)
+	if len(h.undo) > h.depth {
+		h.undo = h.undo[len(h.undo)-h.depth:]
+	}
+	h.redo = nil
+}
+
+// back returns the frame before current and remembers current for forward.
+func (h *frameHistory) back(current []byte) ([]byte, bool) {
+	if len(h.undo) == 0 {
+		return nil, false
+	}
+	frame := h.undo[len(h.undo)-1]
+	h.undo = h.undo[:len(h.undo)-1]
+	h.redo = append(h.redo, current)
+	return frame, true
+}
+
+// forward returns the frame the last back stepped away from and remembers current for back.
+func (h *frameHistory) forward(current []byte) ([]byte, bool) {
+	if len(h.redo) == 0 {
+		return nil, false
+	}
+	frame := h.redo[len(h.redo)-1]
+	h.redo = h.redo[:len(h.redo)-1]
+	h.undo = append(h.undo, current)
+	return frame, true
+}
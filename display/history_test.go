@@ -0,0 +1,50 @@
+package display
+
+import (
+	"context"
+	"testing"
+
+	"go.viam.com/test"
+)
+
+func TestUndoRestoresThePriorBuffer(t *testing.T) {
+	ctx := context.Background()
+	d, bus := newTestDisplay(t, &Config{}, false)
+	test.That(t, d.FillRect(ctx, 10, 10, 20, 20), test.ShouldBeNil)
+	prior, err := d.ReadBuffer(ctx)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, d.WriteString(ctx, 0, 40, "Hi"), test.ShouldBeNil)
+	drawn, err := d.ReadBuffer(ctx)
+	test.That(t, err, test.ShouldBeNil)
+
+	bus.ClearTransfers()
+	test.That(t, d.Undo(ctx), test.ShouldBeNil)
+	buf, err := d.ReadBuffer(ctx)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, buf, test.ShouldResemble, prior)
+	// and the panel was sent it
+	for page, data := range sentPages(bus) {
+		test.That(t, data, test.ShouldResemble, prior[page*64:(page+1)*64])
+	}
+
+	test.That(t, d.Redo(ctx), test.ShouldBeNil)
+	buf, err = d.ReadBuffer(ctx)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, buf, test.ShouldResemble, drawn)
+}
+
+func TestUndoDepth(t *testing.T) {
+	ctx := context.Background()
+	d, _ := newTestDisplay(t, &Config{UndoDepth: 2}, false)
+	for i := 0; i < 3; i++ {
+		test.That(t, d.DrawPixel(ctx, i, 0), test.ShouldBeNil)
+	}
+	test.That(t, d.Undo(ctx), test.ShouldBeNil)
+	test.That(t, d.Undo(ctx), test.ShouldBeNil)
+	test.That(t, d.Undo(ctx), test.ShouldBeError, "nothing to undo")
+	// two draws back, only the first pixel is left
+	buf, err := d.ReadBuffer(ctx)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, d.geom.Pixel(0, 0, buf), test.ShouldBeTrue)
+	test.That(t, d.geom.Pixel(1, 0, buf), test.ShouldBeFalse)
+}
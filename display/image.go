@@ -0,0 +1,113 @@
+package display
+
+import (
+	"image"
+	"image/color"
+)
+
+// imageThreshold is the grey level at or above which an image pixel lights its display pixel.
+const imageThreshold = 0x80
+
+// WriteImageRegion copies the w x h region of src whose top left corner is (srcX, srcY), measured from the top left
+// of src's bounds, so that the region's bottom left corner lands at (dstX, dstY) and the image stays upright. Pixels
+// at least half as bright as white are turned on and darker ones are turned off. Any part of the region that falls
+// outside src is left untouched, so a region that overhangs the image is clipped rather than shifted.
+func (g Geometry) WriteImageRegion(dstX, dstY int, src image.Image, srcX, srcY, w, h int, buf []byte) []byte {
+	bounds := src.Bounds()
+	for row := 0; row < h; row++ {
+		for col := 0; col < w; col++ {
+			p := image.Pt(bounds.Min.X+srcX+col, bounds.Min.Y+srcY+row)
+			if !p.In(bounds) {
+				continue
+			}
+			x, y := dstX+col, dstY+h-1-row
+			if color.GrayModel.Convert(src.At(p.X, p.Y)).(color.Gray).Y >= imageThreshold {
+				buf = g.WritePixel(x, y, buf)
+			} else {
+				buf = g.ClearPixel(x, y, buf)
+			}
+		}
+	}
+	return buf
+}
+
+// WriteImage draws all of img with its bottom left corner at (x, y), thresholded like WriteImageRegion. Unlike the
+// other helpers it clips rather than wraps, so an image bigger than the screen or hanging off its edge shows just the
+// part that's on the screen.
+func (g Geometry) WriteImage(x, y int, img image.Image, buf []byte) []byte {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	// the columns and rows, counted from the image's top left, that land on the screen
+	col0, col1 := 0, w
+	if x < 0 {
+		col0 = -x
+	}
+	if x+w > g.Width {
+		col1 = g.Width - x
+	}
+	row0, row1 := 0, h
+	if y+h > g.Height {
+		row0 = y + h - g.Height
+	}
+	if y < 0 {
+		row1 = y + h
+	}
+	if col1 <= col0 || row1 <= row0 {
+		return buf
+	}
+	return g.WriteImageRegion(x+col0, y+h-row1, img, col0, row0, col1-col0, row1-row0, buf)
+}
+
+// WriteImageDithered draws img like WriteImage, but with Floyd-Steinberg error diffusion instead of a plain threshold,
+// so photos keep their shading as patterns of dots.
+func (g Geometry) WriteImageDithered(x, y int, img image.Image, buf []byte) []byte {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	// the error carried into this row and the next, with a spare column each side so edge pixels need no checks
+	cur, next := make([]float64, w+2), make([]float64, w+2)
+	for row := 0; row < h; row++ {
+		for col := 0; col < w; col++ {
+			grey := float64(color.GrayModel.Convert(img.At(bounds.Min.X+col, bounds.Min.Y+row)).(color.Gray).Y)
+			want := grey + cur[col+1]
+			on := want >= imageThreshold
+			diff := want
+			if on {
+				diff -= 0xFF
+			}
+			cur[col+2] += diff * 7 / 16
+			next[col] += diff * 3 / 16
+			next[col+1] += diff * 5 / 16
+			next[col+2] += diff * 1 / 16
+
+			px, py := x+col, y+h-1-row
+			if px < 0 || py < 0 || px >= g.Width || py >= g.Height {
+				continue
+			}
+			if on {
+				buf = g.WritePixel(px, py, buf)
+			} else {
+				buf = g.ClearPixel(px, py, buf)
+			}
+		}
+		cur, next = next, cur
+		for i := range next {
+			next[i] = 0
+		}
+	}
+	return buf
+}
+
+// BufferToImage unpacks buf, a full frame packed as for Geometry{Width: width, Height: height}, into an upright image
+// with lit pixels white and the rest black, e.g. to save a screenshot taken with ReadBuffer. It undoes WriteImage
+// drawing a width x height image at (0, 0).
+func BufferToImage(buf []byte, width, height int) *image.Gray {
+	g := Geometry{Width: width, Height: height}
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if g.Pixel(x, y, buf) {
+				img.SetGray(x, height-1-y, color.Gray{Y: 0xFF})
+			}
+		}
+	}
+	return img
+}
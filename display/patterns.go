@@ -0,0 +1,60 @@
+package display
+
+import "fmt"
+
+// bayer4 is the 4x4 ordered dithering matrix used to approximate grey levels on the 1-bit panel.
+var bayer4 = [4][4]int{
+	{0, 8, 2, 10},
+	{12, 4, 14, 6},
+	{3, 11, 1, 9},
+	{15, 7, 13, 5},
+}
+
+// TestPattern returns a buffer filled with one of the named test patterns. These light every pixel in a predictable
+// way, so dead rows/columns and addressing bugs stand out when bringing up a new panel. They always cover the whole
+// panel, whatever Scale is set.
+func (g Geometry) TestPattern(pattern string) ([]byte, error) {
+	g.Scale = 0
+	switch pattern {
+	case "all-off":
+		return g.Blank(), nil
+	case "all-on":
+		buf := g.Blank()
+		for i := range buf {
+			buf[i] = 0xFF
+		}
+		return buf, nil
+	case "border":
+		buf := g.Blank()
+		buf = g.WriteLine(0, 0, g.Width-1, 0, buf)
+		buf = g.WriteLine(0, g.Height-1, g.Width-1, g.Height-1, buf)
+		buf = g.WriteLine(0, 0, 0, g.Height-1, buf)
+		buf = g.WriteLine(g.Width-1, 0, g.Width-1, g.Height-1, buf)
+		return buf, nil
+	}
+
+	var on func(x, y int) bool
+	switch pattern {
+	case "checkerboard":
+		on = func(x, y int) bool { return (x+y)%2 == 0 }
+	case "stripes-h":
+		on = func(x, y int) bool { return y%2 == 0 }
+	case "stripes-v":
+		on = func(x, y int) bool { return x%2 == 0 }
+	case "gradient":
+		// dark on the left, fully lit on the right
+		on = func(x, y int) bool { return bayer4[y%4][x%4] < (x*17)/g.Width }
+	default:
+		return nil, fmt.Errorf("unknown test pattern %q", pattern)
+	}
+
+	buf := g.Blank()
+	for x := 0; x < g.Width; x++ {
+		for y := 0; y < g.Height; y++ {
+			if on(x, y) {
+				buf = g.WritePixel(x, y, buf)
+			}
+		}
+	}
+	return buf, nil
+}
@@ -0,0 +1,59 @@
+package display
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// panelPreset is the controller and layout of a known panel, selected with the preset attribute.
+type panelPreset struct {
+	controller    string
+	width, height int
+	// where the panel's visible area starts in the controller's RAM, see Config.ColumnOffset
+	columnOffset, pageOffset int
+	// the SSD1306 COM pins are in the alternative configuration even though there are fewer than 64 rows
+	altComPins bool
+}
+
+// presets are the panels that can be set up by name. The 0.42" 72x40 panels are an SSD1306 showing 72 of its 128
+// columns, from column 28, and the top 40 of its 64 rows, wired like a 64 row panel.
+var presets = map[string]panelPreset{
+	"72x40": {controller: controllerSSD1306, width: 72, height: 40, columnOffset: 28, altComPins: true},
+}
+
+// presetNames lists the presets for error messages.
+func presetNames() string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, fmt.Sprintf("%q", name))
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// withPreset returns config with its preset's values filled in wherever config leaves them unset, so explicit
+// attributes can still adjust a preset, e.g. a panel whose visible area starts a column further along.
+func (config *Config) withPreset() Config {
+	resolved := *config
+	preset, ok := presets[config.Preset]
+	if !ok {
+		return resolved
+	}
+	if resolved.Controller == "" {
+		resolved.Controller = preset.controller
+	}
+	if resolved.Width == 0 {
+		resolved.Width = preset.width
+	}
+	if resolved.Height == 0 {
+		resolved.Height = preset.height
+	}
+	if resolved.ColumnOffset == 0 {
+		resolved.ColumnOffset = preset.columnOffset
+	}
+	if resolved.PageOffset == 0 {
+		resolved.PageOffset = preset.pageOffset
+	}
+	return resolved
+}
@@ -0,0 +1,51 @@
+package display
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"go.viam.com/test"
+)
+
+func TestPreset72x40(t *testing.T) {
+	d, bus := newTestDisplay(t, &Config{Preset: "72x40"}, true)
+	w, h, err := d.GetDimensions(context.Background())
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, []int{w, h}, test.ShouldResemble, []int{72, 40})
+
+	// 40 rows multiplexed, with the COM pins in the alternative configuration
+	init := transferData(bus)[0]
+	test.That(t, init, test.ShouldContain, sh110xSETMULTIPLEX)
+	test.That(t, init[bytes.IndexByte(init, sh110xSETMULTIPLEX)+1], test.ShouldEqual, 39)
+	test.That(t, init[bytes.IndexByte(init, sh110xSETCOMPINS)+1], test.ShouldEqual, 0x12)
+
+	// a whole frame fills columns 28 to 99 of the RAM, a page's worth at a time, in pages 0 to 4
+	bus.ClearTransfers()
+	test.That(t, d.Refresh(context.Background()), test.ShouldBeNil)
+	var windows [][]byte
+	for _, data := range transferData(bus) {
+		if data[0] == ctrlCommand && data[1] == sh110xCOLUMNADDR {
+			windows = append(windows, data[1:])
+		}
+	}
+	test.That(t, windows, test.ShouldHaveLength, 9)
+	for i, window := range windows {
+		start := byte(28 + i*8)
+		test.That(t, window, test.ShouldResemble, []byte{sh110xCOLUMNADDR, start, start + 7, sh110xPAGEADDR, 0, 4})
+	}
+	test.That(t, windows[0][1], test.ShouldEqual, 28)
+	test.That(t, windows[8][2], test.ShouldEqual, 99)
+}
+
+func TestPresetAttributesOverride(t *testing.T) {
+	attr := &Config{Preset: "72x40", ColumnOffset: 30}
+	resolved := attr.withPreset()
+	test.That(t, resolved.Controller, test.ShouldEqual, controllerSSD1306)
+	test.That(t, resolved.ColumnOffset, test.ShouldEqual, 30)
+
+	_, err := (&Config{I2CBus: "1", Preset: "96x16"}).Validate("test")
+	test.That(t, err, test.ShouldNotBeNil)
+	_, err = (&Config{I2CBus: "1", Preset: "72x40", ColumnOffset: 60}).Validate("test")
+	test.That(t, err, test.ShouldNotBeNil)
+}
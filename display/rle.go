@@ -0,0 +1,103 @@
+package display
+
+import "fmt"
+
+// The run-length encoding used by DisplayBytesRLE is a sequence of (count, value) byte pairs, each standing for count
+// repeats of value. count is 1 to 255; longer runs are split across pairs. A blank 1024 byte frame encodes to 10 bytes.
+
+// EncodeRLE run-length encodes a frame buffer for DisplayBytesRLE.
+func EncodeRLE(buf []byte) []byte {
+	var out []byte
+	for i := 0; i < len(buf); {
+		run := 1
+		for i+run < len(buf) && buf[i+run] == buf[i] && run < 255 {
+			run++
+		}
+		out = append(out, byte(run), buf[i])
+		i += run
+	}
+	return out
+}
+
+// DecodeRLE expands data produced by EncodeRLE.
+func DecodeRLE(encoded []byte) ([]byte, error) {
+	if len(encoded)%2 != 0 {
+		return nil, fmt.Errorf("run-length data must be (count, value) pairs, got %d bytes", len(encoded))
+	}
+	var out []byte
+	for i := 0; i < len(encoded); i += 2 {
+		if encoded[i] == 0 {
+			return nil, fmt.Errorf("run-length pair at byte %d has a zero count", i)
+		}
+		for n := 0; n < int(encoded[i]); n++ {
+			out = append(out, encoded[i+1])
+		}
+	}
+	return out, nil
+}
+
+// Bitmaps for DrawBitmapRLE use a pixel-level encoding instead, since icons are rarely byte aligned. Pixels are taken
+// row by row from the top left, as in an image, and each byte is a run of them: the top bit is the pixels' value and
+// the low seven bits are the run length, 1 to 127.
+
+// EncodeBitmapRLE run-length encodes a w x h bitmap, packed as for Geometry{Width: w, Height: h}, for DrawBitmapRLE.
+func EncodeBitmapRLE(w, h int, bitmap []byte) []byte {
+	src := Geometry{Width: w, Height: h}
+	var out []byte
+	run, on := 0, false
+	for row := 0; row < h; row++ {
+		for col := 0; col < w; col++ {
+			pix := src.Pixel(col, h-1-row, bitmap)
+			if run > 0 && (pix != on || run == 127) {
+				out = append(out, bitmapRun(run, on))
+				run = 0
+			}
+			run, on = run+1, pix
+		}
+	}
+	if run > 0 {
+		out = append(out, bitmapRun(run, on))
+	}
+	return out
+}
+
+func bitmapRun(n int, on bool) byte {
+	if on {
+		return 0x80 | byte(n)
+	}
+	return byte(n)
+}
+
+// DecodeBitmapRLE expands data produced by EncodeBitmapRLE into a w x h bitmap, which it must cover exactly. The size
+// is checked before anything is allocated, so a bitmap no panel could show, or one too big for the runs given to
+// cover, is rejected however little data came with it.
+func DecodeBitmapRLE(w, h int, encoded []byte) ([]byte, error) {
+	if w <= 0 || h <= 0 || w > maxWidth || h > maxHeight {
+		return nil, fmt.Errorf("bitmap must be between 1x1 and %dx%d, got %dx%d", maxWidth, maxHeight, w, h)
+	}
+	if w*h > 127*len(encoded) {
+		return nil, fmt.Errorf("%d bitmap runs can't cover the %d pixels of a %dx%d bitmap", len(encoded), w*h, w, h)
+	}
+	dst := Geometry{Width: w, Height: h}
+	bitmap := dst.Blank()
+	n := 0
+	for i, b := range encoded {
+		run := int(b & 0x7F)
+		if run == 0 {
+			return nil, fmt.Errorf("bitmap run at byte %d has a zero length", i)
+		}
+		if n+run > w*h {
+			return nil, fmt.Errorf("bitmap runs cover more than the %d pixels of a %dx%d bitmap", w*h, w, h)
+		}
+		for ; run > 0; run-- {
+			if b&0x80 != 0 {
+				bitmap = dst.WritePixel(n%w, h-1-n/w, bitmap)
+			}
+			n++
+		}
+	}
+	if n != w*h {
+		return nil, fmt.Errorf("bitmap runs cover %d pixels, a %dx%d bitmap has %d", n, w, h, w*h)
+	}
+	return bitmap, nil
+}
@@ -0,0 +1,72 @@
+package display
+
+import (
+	"context"
+	"testing"
+
+	"go.viam.com/test"
+)
+
+func TestRLERoundTrip(t *testing.T) {
+	d, _ := newTestDisplay(t, &Config{}, false)
+	// a mostly blank frame: a short line of text in an otherwise empty screen
+	frame := d.geom.WriteString(4, 60, "hi", d.geom.Blank())
+	encoded := EncodeRLE(frame)
+	test.That(t, len(encoded), test.ShouldBeLessThan, len(frame)/8)
+	decoded, err := DecodeRLE(encoded)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, decoded, test.ShouldResemble, frame)
+
+	// a blank frame's runs are all split at 255
+	blank := EncodeRLE(d.geom.Blank())
+	test.That(t, len(blank), test.ShouldEqual, 2*((len(frame)+254)/255))
+	test.That(t, blank[0], test.ShouldEqual, 255)
+
+	test.That(t, d.DisplayBytesRLE(context.Background(), encoded), test.ShouldBeNil)
+	test.That(t, d.current, test.ShouldResemble, frame)
+}
+
+func TestBitmapRLERoundTrip(t *testing.T) {
+	// a 16x12 battery icon: an outline with a terminal nub on the right and two bars of charge
+	icon := Geometry{Width: 16, Height: 12}
+	bitmap := icon.WriteRect(0, 1, 14, 10, icon.Blank())
+	bitmap = icon.setRect(14, 4, 2, 4, true, bitmap)
+	bitmap = icon.setRect(2, 3, 3, 6, true, bitmap)
+	bitmap = icon.setRect(6, 3, 3, 6, true, bitmap)
+
+	encoded := EncodeBitmapRLE(16, 12, bitmap)
+	decoded, err := DecodeBitmapRLE(16, 12, encoded)
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, decoded, test.ShouldResemble, bitmap)
+
+	d, _ := newTestDisplay(t, &Config{}, false)
+	test.That(t, d.DrawBitmapRLE(context.Background(), 40, 20, 16, 12, encoded), test.ShouldBeNil)
+	buf, err := d.ReadBuffer(context.Background())
+	test.That(t, err, test.ShouldBeNil)
+	g := d.geom
+	litOnly(t, g, buf, 0, 0, g.Width, g.Height, func(px, py int) bool {
+		return px >= 40 && px < 56 && py >= 20 && py < 32 && icon.Pixel(px-40, py-20, bitmap)
+	})
+}
+
+func TestDecodeBitmapRLESize(t *testing.T) {
+	// a huge bitmap is turned away before anything is allocated for it
+	_, err := DecodeBitmapRLE(1<<31, 1<<31, []byte{0x7F})
+	test.That(t, err, test.ShouldBeError)
+	// as is one the runs given can't cover
+	_, err = DecodeBitmapRLE(128, 128, []byte{0x7F, 0x7F})
+	test.That(t, err, test.ShouldBeError)
+	_, err = DecodeBitmapRLE(0, 8, nil)
+	test.That(t, err, test.ShouldBeError)
+
+	d, _ := newTestDisplay(t, &Config{}, false)
+	test.That(t, d.DrawBitmapRLE(context.Background(), 0, 0, 128, 128, EncodeBitmapRLE(128, 128, make([]byte, 128*128/8))),
+		test.ShouldBeError)
+
+	splash := &BootSequence{Splash: []byte{0x7F}, Width: 1 << 20, Height: 1 << 20}
+	test.That(t, splash.validate(128, 64), test.ShouldBeError)
+	_, err = (&Config{I2CBus: "1", Rotation: 90, BootSequence: &BootSequence{
+		Splash: EncodeBitmapRLE(128, 8, make([]byte, 128)), Width: 128, Height: 8,
+	}}).Validate("test")
+	test.That(t, err, test.ShouldBeError)
+}
@@ -0,0 +1,35 @@
+package display
+
+// rotated returns the geometry of the screen drawing sees when the image on a panel of this geometry is turned
+// clockwise by rotation degrees, which must be 0, 90, 180 or 270.
+func (g Geometry) rotated(rotation int) Geometry {
+	if rotation == 90 || rotation == 270 {
+		g.Width, g.Height = g.Height, g.Width
+	}
+	return g
+}
+
+// rotateFrom lays buf, a frame of the rotated geometry src, out the way this panel geometry is, turning the image
+// clockwise by rotation degrees.
+func (g Geometry) rotateFrom(src Geometry, buf []byte, rotation int) []byte {
+	src.Scale = 0
+	out := g.Blank()
+	for x := 0; x < src.Width; x++ {
+		for y := 0; y < src.Height; y++ {
+			if !src.Pixel(x, y, buf) {
+				continue
+			}
+			px, py := x, y
+			switch rotation {
+			case 90:
+				px, py = y, src.Width-1-x
+			case 180:
+				px, py = src.Width-1-x, src.Height-1-y
+			case 270:
+				px, py = src.Height-1-y, x
+			}
+			out[g.index(px, py)] |= 1 << (px & 7)
+		}
+	}
+	return out
+}
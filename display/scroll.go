@@ -0,0 +1,102 @@
+package display
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.viam.com/utils"
+)
+
+// ScrollText scrolls text across the screen from right to left, a pixel every speed milliseconds, with its baseline
+// at y, and returns once it has gone off the left edge or ctx is done. Everything outside the band of rows the text
+// passes through is left alone. The frames go straight to the panel without joining the Undo history, and other
+// draws can go ahead between frames.
+func (d *display) ScrollText(ctx context.Context, y int, text string, speed int) error {
+	if speed <= 0 {
+		return fmt.Errorf("scroll speed must be a positive number of milliseconds per pixel, got %d", speed)
+	}
+	d.mu.Lock()
+	d.marquees++
+	defer func() {
+		d.mu.Lock()
+		d.marquees--
+		d.mu.Unlock()
+	}()
+	// the animation is in screen pixels whatever SetScale says, as a sprite can't be scaled
+	geom := d.geom
+	geom.Scale = 0
+	sprite, w, h := geom.RenderStringSprite(text)
+	bottom := d.baseline(y) - geom.font().descent()
+	d.mu.Unlock()
+	if sprite == nil {
+		return nil
+	}
+
+	for x := geom.Width; x >= -w; x-- {
+		d.mu.Lock()
+		frame := make([]byte, len(d.current))
+		copy(frame, d.current)
+		frame = geom.setRect(0, bottom, geom.Width, h, false, frame)
+		frame = geom.WriteSprite(x, bottom, w, h, sprite, frame)
+		err := d.writeBuf(ctx, frame)
+		d.mu.Unlock()
+		if err != nil {
+			return err
+		}
+		if !utils.SelectContextOrWait(ctx, time.Duration(speed)*time.Millisecond) {
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// scrollDirections maps the directions StartScroll takes to the command that sets each up.
+var scrollDirections = map[string]byte{
+	"left":  ssd1306LEFTHORIZONTALSCROLL,
+	"right": ssd1306RIGHTHORIZONTALSCROLL,
+}
+
+// StartScroll has an SSD1306 scroll its pages startPage through endPage, 8 pixel high bands counted from the top of
+// the panel, continuously left or right, wrapping around, without any more traffic on the bus. The next draw stops it.
+// The SH110x controllers have no scroll commands at all, so on them it fails and ScrollText is the way to scroll.
+func (d *display) StartScroll(ctx context.Context, direction string, startPage, endPage int) error {
+	cmd, ok := scrollDirections[direction]
+	if !ok {
+		return fmt.Errorf(`scroll direction must be "left" or "right", got %q`, direction)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.controller != controllerSSD1306 {
+		return fmt.Errorf("hardware scrolling needs an ssd1306 controller, the %s has no scroll commands", d.controller)
+	}
+	if pages := d.panel.Height / 8; startPage < 0 || startPage > endPage || endPage >= pages {
+		return fmt.Errorf("scroll pages must run from 0 to %d with the start not after the end, got %d to %d",
+			pages-1, startPage, endPage)
+	}
+	// a new scroll can only be set up while scrolling is off
+	if err := d.sendCommand(ctx, ssd1306DEACTIVATESCROLL); err != nil {
+		return err
+	}
+	// a dummy byte, the start page, the step interval (0 is every 5 frames), the end page and two more dummies, with
+	// the pages counted in the controller's RAM, where the panel may not start at page 0
+	start, end := byte(startPage+d.pageOffset), byte(endPage+d.pageOffset)
+	if err := d.sendCommand(ctx, cmd, 0x00, start, 0x00, end, 0x00, 0xFF); err != nil {
+		return err
+	}
+	if err := d.sendCommand(ctx, ssd1306ACTIVATESCROLL); err != nil {
+		return err
+	}
+	d.scrolling = true
+	return nil
+}
+
+// StopScroll stops a scroll StartScroll started and puts the current frame back, since scrolling has moved it.
+func (d *display) StopScroll(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.scrolling {
+		return nil
+	}
+	return d.writeBuf(ctx, d.current)
+}
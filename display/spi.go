@@ -0,0 +1,140 @@
+package display
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.viam.com/rdk/components/board"
+	"go.viam.com/rdk/components/board/genericlinux/buses"
+	"go.viam.com/rdk/resource"
+)
+
+// Supported values for the transport attribute, which says how the panel is wired.
+const (
+	transportI2C = "i2c"
+	transportSPI = "spi"
+)
+
+// SPI settings: panels latch data on the rising clock edge with the clock idling low (mode 0), and the SSD1306 and
+// SH110x are good for 10MHz, so defaultSPIBaud leaves some margin.
+const (
+	spiMode        = 0
+	defaultSPIBaud = 8000000
+)
+
+// spiMaxTransfer is the most display data sent in one SPI transfer, spidev's default buffer size. It's more than a
+// page, so every page goes in one.
+const spiMaxTransfer = 4096
+
+// resetPulse is how long the reset pin is held low to reset the controller, and how long it's given to come back.
+const resetPulse = 10 * time.Millisecond
+
+var errSPIRead = errors.New("the display can't be read over SPI")
+
+// spiBus drives a panel wired for 4-wire SPI through the buses.I2C interface the rest of the display talks to. The
+// control byte at the start of each transfer sets the data/command pin instead of being sent, and the I2C address
+// is ignored. Nothing can be read back, so status reads fail with errSPIRead.
+type spiBus struct {
+	bus        buses.SPI
+	chipSelect string
+	baud       uint
+	dc         board.GPIOPin
+}
+
+// newSPIBus opens the SPI bus in attr and looks up its pins on the board, pulsing the reset pin if there is one so
+// the controller starts from a known state.
+func newSPIBus(ctx context.Context, deps resource.Dependencies, attr *Config) (*spiBus, error) {
+	b, err := board.FromDependencies(deps, attr.Board)
+	if err != nil {
+		return nil, err
+	}
+	dc, err := b.GPIOPinByName(attr.DCPin)
+	if err != nil {
+		return nil, fmt.Errorf("dc_pin: %w", err)
+	}
+	if attr.ResetPin != "" {
+		reset, err := b.GPIOPinByName(attr.ResetPin)
+		if err != nil {
+			return nil, fmt.Errorf("reset_pin: %w", err)
+		}
+		if err := pulseReset(ctx, reset); err != nil {
+			return nil, fmt.Errorf("resetting the display: %w", err)
+		}
+	}
+	baud := uint(defaultSPIBaud)
+	if attr.SPIBaudHz > 0 {
+		baud = uint(attr.SPIBaudHz)
+	}
+	return &spiBus{
+		bus:        buses.NewSpiBus(attr.SPIBus),
+		chipSelect: attr.ChipSelect,
+		baud:       baud,
+		dc:         dc,
+	}, nil
+}
+
+// pulseReset holds the active low reset pin down for resetPulse and then waits as long again for the controller.
+func pulseReset(ctx context.Context, reset board.GPIOPin) error {
+	if err := reset.Set(ctx, false, nil); err != nil {
+		return err
+	}
+	time.Sleep(resetPulse)
+	if err := reset.Set(ctx, true, nil); err != nil {
+		return err
+	}
+	time.Sleep(resetPulse)
+	return nil
+}
+
+// OpenHandle locks the SPI bus for one exchange with the panel.
+func (b *spiBus) OpenHandle(addr byte) (buses.I2CHandle, error) {
+	handle, err := b.bus.OpenHandle()
+	if err != nil {
+		return nil, err
+	}
+	return &spiHandle{bus: b, handle: handle}, nil
+}
+
+type spiHandle struct {
+	bus    *spiBus
+	handle buses.SPIHandle
+}
+
+// Write sends tx, a control byte and what it introduces, with the data/command pin high for display data and low
+// for commands.
+func (h *spiHandle) Write(ctx context.Context, tx []byte) error {
+	if len(tx) < 2 {
+		return nil
+	}
+	if err := h.bus.dc.Set(ctx, tx[0]&ctrlData != 0, nil); err != nil {
+		return fmt.Errorf("setting the data/command pin: %w", err)
+	}
+	_, err := h.handle.Xfer(ctx, h.bus.baud, h.bus.chipSelect, spiMode, tx[1:])
+	return err
+}
+
+func (h *spiHandle) Read(ctx context.Context, count int) ([]byte, error) {
+	return nil, errSPIRead
+}
+
+func (h *spiHandle) ReadByteData(ctx context.Context, register byte) (byte, error) {
+	return 0, errSPIRead
+}
+
+func (h *spiHandle) WriteByteData(ctx context.Context, register, data byte) error {
+	return errors.New("register writes aren't supported over SPI")
+}
+
+func (h *spiHandle) ReadBlockData(ctx context.Context, register byte, numBytes uint8) ([]byte, error) {
+	return nil, errSPIRead
+}
+
+func (h *spiHandle) WriteBlockData(ctx context.Context, register byte, data []byte) error {
+	return errors.New("register writes aren't supported over SPI")
+}
+
+func (h *spiHandle) Close() error {
+	return h.handle.Close()
+}
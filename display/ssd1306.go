@@ -0,0 +1,85 @@
+package display
+
+import (
+	"context"
+	"time"
+
+	"go.viam.com/utils"
+)
+
+// ssd1306MaxHeight is the most rows an SSD1306 drives.
+const ssd1306MaxHeight = 64
+
+// initSSD1306 is initDisp for an SSD1306. Unlike the SH110x it has a charge pump to turn on, and it's set to
+// horizontal addressing so a frame can go out through a column window (see ssd1306Window).
+func (d *display) initSSD1306(ctx context.Context) error {
+	handle, err := d.bus.OpenHandle(d.addr)
+	if err != nil {
+		return err
+	}
+	defer utils.UncheckedErrorFunc(handle.Close)
+
+	// alternative COM pin configuration for 64 rows and panels wired like them, sequential for fewer
+	comPins := byte(0x02)
+	if d.ssd1306ComPins {
+		comPins = 0x12
+	}
+	// column 0 and row 0 are the top left with COMSCANDEC, so the com_scan attribute's "inc" is the flipped one
+	comScan := oppositeComScan(d.comScan)
+	init := d.command(
+		sh110xDISPLAYOFF,               // 0xAE
+		sh110xSETDISPLAYCLOCKDIV, 0x80, // 0xD5, 0x80
+		sh110xSETMULTIPLEX, byte(d.panel.Height-1), // 0xA8, 0x3F for 64 rows
+		sh110xSETDISPLAYOFFSET, 0x00, // 0xD3, 0x00
+		sh110xSETSTARTLINE,     // 0x40
+		sh110xCHARGEPUMP, 0x14, // 0x8D, 0x14, internal charge pump on
+		sh110xMEMORYMODE, 0x00, // 0x20, 0x00, horizontal addressing
+		d.segRemap(),              // 0xA1, or 0xA0 if flipped
+		comScan,                   // 0xC8 or 0xC0
+		sh110xSETCOMPINS, comPins, // 0xDA, 0x12 or 0x02
+		sh110xSETCONTRAST, d.contrast, // 0x81, 0x4F by default
+		sh110xSETPRECHARGE, 0xF1, // 0xD9, 0xF1
+		sh110xSETVCOMDETECT, 0x40, // 0xDB, 0x40
+		sh110xDISPLAYALLONRESUME, // 0xA4
+		d.displayMode(),          // 0xA6, or 0xA7 if inverted
+	)
+	if err := handle.Write(ctx, init); err != nil {
+		return err
+	}
+
+	time.Sleep(d.initDelay)
+
+	if !d.displayOff {
+		return handle.Write(ctx, d.command(sh110xDISPLAYON))
+	}
+	return nil
+}
+
+// ssd1306Window addresses the 8 columns the frame's page covers, across every row, so the page can be sent as one
+// run of data.
+func (d *display) ssd1306Window(page int) []byte {
+	return d.command(
+		sh110xCOLUMNADDR, byte(d.columnOffset+page*8), byte(d.columnOffset+page*8+7),
+		sh110xPAGEADDR, byte(d.pageOffset), byte(d.pageOffset+d.panel.Height/8-1),
+	)
+}
+
+// ssd1306Band repacks page of buf, an 8 pixel wide column band, the way an SSD1306 in horizontal addressing expects
+// it: its own pages are 8 row bands from the top, each byte is a column of 8 pixels with the lowest bit on top, and
+// the window is filled row band by row band.
+func (g Geometry) ssd1306Band(buf []byte, page int) []byte {
+	out := make([]byte, g.Height)
+	for band := 0; band < g.Height/8; band++ {
+		for col := 0; col < 8; col++ {
+			var b byte
+			for bit := 0; bit < 8; bit++ {
+				y := g.Height - 1 - (band*8 + bit)
+				if buf[g.index(page*8+col, y)]&(1<<col) != 0 {
+					b |= 1 << bit
+				}
+			}
+			out[band*8+col] = b
+		}
+	}
+	return out
+}
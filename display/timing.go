@@ -0,0 +1,40 @@
+package display
+
+import "time"
+
+// flushTimingSamples is how many recent writeBuf durations are kept for the flush_timing DoCommand.
+const flushTimingSamples = 32
+
+// flushTiming is a ring buffer of the most recent flush durations.
+type flushTiming struct {
+	samples [flushTimingSamples]time.Duration
+	next    int
+	count   int
+}
+
+func (t *flushTiming) add(dur time.Duration) {
+	t.samples[t.next] = dur
+	t.next = (t.next + 1) % len(t.samples)
+	if t.count < len(t.samples) {
+		t.count++
+	}
+}
+
+// stats returns the min, average and max of the recorded durations, all zero if there are none.
+func (t *flushTiming) stats() (lo, avg, hi time.Duration) {
+	if t.count == 0 {
+		return 0, 0, 0
+	}
+	var sum time.Duration
+	lo = t.samples[0]
+	for _, dur := range t.samples[:t.count] {
+		sum += dur
+		if dur < lo {
+			lo = dur
+		}
+		if dur > hi {
+			hi = dur
+		}
+	}
+	return lo, sum / time.Duration(t.count), hi
+}
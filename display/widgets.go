@@ -0,0 +1,431 @@
+package display
+
+import (
+	"fmt"
+	"math"
+)
+
+// setRect turns every pixel of the w x h rectangle whose bottom left corner is (x, y) on, or off if on is false.
+func (g Geometry) setRect(x, y, w, h int, on bool, buf []byte) []byte {
+	for xx := x; xx < x+w; xx++ {
+		for yy := y; yy < y+h; yy++ {
+			if on {
+				buf = g.WritePixel(xx, yy, buf)
+			} else {
+				buf = g.ClearPixel(xx, yy, buf)
+			}
+		}
+	}
+	return buf
+}
+
+// writeCircleQuadrants draws the selected quarters of the circle of radius r around (cx, cy) with the midpoint
+// algorithm. The quadrant bits are 1 for up-right, 2 for up-left, 4 for down-left and 8 for down-right.
+func (g Geometry) writeCircleQuadrants(cx, cy, r int, quadrants byte, buf []byte) []byte {
+	f := 1 - r
+	ddx := 1
+	ddy := -2 * r
+	x, y := 0, r
+	for x < y {
+		if f >= 0 {
+			y--
+			ddy += 2
+			f += ddy
+		}
+		x++
+		ddx += 2
+		f += ddx
+		if quadrants&1 != 0 {
+			buf = g.WritePixel(cx+x, cy+y, buf)
+			buf = g.WritePixel(cx+y, cy+x, buf)
+		}
+		if quadrants&2 != 0 {
+			buf = g.WritePixel(cx-x, cy+y, buf)
+			buf = g.WritePixel(cx-y, cy+x, buf)
+		}
+		if quadrants&4 != 0 {
+			buf = g.WritePixel(cx-x, cy-y, buf)
+			buf = g.WritePixel(cx-y, cy-x, buf)
+		}
+		if quadrants&8 != 0 {
+			buf = g.WritePixel(cx+x, cy-y, buf)
+			buf = g.WritePixel(cx+y, cy-x, buf)
+		}
+	}
+	return buf
+}
+
+// WriteCircle outlines the circle of radius r around (cx, cy). Radius zero is a single pixel.
+func (g Geometry) WriteCircle(cx, cy, r int, buf []byte) []byte {
+	// the quadrant helper leaves out the four points on the axes
+	buf = g.WritePixel(cx, cy+r, buf)
+	buf = g.WritePixel(cx, cy-r, buf)
+	buf = g.WritePixel(cx+r, cy, buf)
+	buf = g.WritePixel(cx-r, cy, buf)
+	return g.writeCircleQuadrants(cx, cy, r, 15, buf)
+}
+
+// WriteFillCircle fills the circle of radius r around (cx, cy), the same shape WriteCircle outlines.
+func (g Geometry) WriteFillCircle(cx, cy, r int, buf []byte) []byte {
+	buf = g.WriteLine(cx, cy-r, cx, cy+r, buf)
+	f := 1 - r
+	ddx := 1
+	ddy := -2 * r
+	x, y := 0, r
+	for x < y {
+		if f >= 0 {
+			y--
+			ddy += 2
+			f += ddy
+		}
+		x++
+		ddx += 2
+		f += ddx
+		buf = g.WriteLine(cx+x, cy-y, cx+x, cy+y, buf)
+		buf = g.WriteLine(cx-x, cy-y, cx-x, cy+y, buf)
+		buf = g.WriteLine(cx+y, cy-x, cx+y, cy+x, buf)
+		buf = g.WriteLine(cx-y, cy-x, cx-y, cy+x, buf)
+	}
+	return buf
+}
+
+// ellipseQuadrant calls fn with every point of the up-right quarter of an ellipse with radii rx and ry, as offsets
+// from its center, found with the midpoint ellipse algorithm. Both radii must be positive.
+func ellipseQuadrant(rx, ry int, fn func(x, y int)) {
+	rx2, ry2 := rx*rx, ry*ry
+	x, y := 0, ry
+	dx, dy := 0, 2*rx2*y
+	// where the curve is flatter than 45 degrees x steps every point
+	p := ry2 - rx2*ry + rx2/4
+	for dx < dy {
+		fn(x, y)
+		x++
+		dx += 2 * ry2
+		if p < 0 {
+			p += dx + ry2
+		} else {
+			y--
+			dy -= 2 * rx2
+			p += dx - dy + ry2
+		}
+	}
+	// and after that y does
+	p = ry2*(2*x+1)*(2*x+1)/4 + rx2*(y-1)*(y-1) - rx2*ry2
+	for y >= 0 {
+		fn(x, y)
+		y--
+		dy -= 2 * rx2
+		if p > 0 {
+			p += rx2 - dy
+		} else {
+			x++
+			dx += 2 * ry2
+			p += dx - dy + rx2
+		}
+	}
+}
+
+// WriteEllipse outlines the ellipse around (cx, cy) with horizontal radius rx and vertical radius ry. A zero radius
+// flattens it into a line.
+func (g Geometry) WriteEllipse(cx, cy, rx, ry int, buf []byte) []byte {
+	if rx == 0 || ry == 0 {
+		return g.WriteLine(cx-rx, cy-ry, cx+rx, cy+ry, buf)
+	}
+	ellipseQuadrant(rx, ry, func(x, y int) {
+		buf = g.WritePixel(cx+x, cy+y, buf)
+		buf = g.WritePixel(cx-x, cy+y, buf)
+		buf = g.WritePixel(cx-x, cy-y, buf)
+		buf = g.WritePixel(cx+x, cy-y, buf)
+	})
+	return buf
+}
+
+// WriteFillEllipse fills the ellipse WriteEllipse outlines.
+func (g Geometry) WriteFillEllipse(cx, cy, rx, ry int, buf []byte) []byte {
+	if rx == 0 || ry == 0 {
+		return g.WriteLine(cx-rx, cy-ry, cx+rx, cy+ry, buf)
+	}
+	ellipseQuadrant(rx, ry, func(x, y int) {
+		buf = g.WriteLine(cx+x, cy-y, cx+x, cy+y, buf)
+		buf = g.WriteLine(cx-x, cy-y, cx-x, cy+y, buf)
+	})
+	return buf
+}
+
+// WriteTriangle outlines the triangle with corners (x0, y0), (x1, y1) and (x2, y2).
+func (g Geometry) WriteTriangle(x0, y0, x1, y1, x2, y2 int, buf []byte) []byte {
+	buf = g.WriteLine(x0, y0, x1, y1, buf)
+	buf = g.WriteLine(x1, y1, x2, y2, buf)
+	return g.WriteLine(x2, y2, x0, y0, buf)
+}
+
+// WriteFillTriangle fills the triangle WriteTriangle outlines, one row at a time. Corners in a line fill as the line
+// through them.
+func (g Geometry) WriteFillTriangle(x0, y0, x1, y1, x2, y2 int, buf []byte) []byte {
+	// sort the corners bottom to top
+	if y0 > y1 {
+		x0, y0, x1, y1 = x1, y1, x0, y0
+	}
+	if y1 > y2 {
+		x1, y1, x2, y2 = x2, y2, x1, y1
+	}
+	if y0 > y1 {
+		x0, y0, x1, y1 = x1, y1, x0, y0
+	}
+	// each row runs from the long edge, bottom corner to top, to whichever short edge spans it. The outline drawn
+	// afterwards covers the top row, and the whole of a flat triangle.
+	for y := y0; y < y2; y++ {
+		var short int
+		if y < y1 {
+			short = edgeX(x0, y0, x1, y1, y)
+		} else {
+			short = edgeX(x1, y1, x2, y2, y)
+		}
+		buf = g.WriteLine(edgeX(x0, y0, x2, y2, y), y, short, y, buf)
+	}
+	return g.WriteTriangle(x0, y0, x1, y1, x2, y2, buf)
+}
+
+// edgeX is the x, to the nearest pixel, where the edge from (x0, y0) to (x1, y1) crosses row y, which must lie
+// between them. A flat edge crosses its row at x0.
+func edgeX(x0, y0, x1, y1, y int) int {
+	if y1 == y0 {
+		return x0
+	}
+	return x0 + int(math.Round(float64((x1-x0)*(y-y0))/float64(y1-y0)))
+}
+
+// WriteRoundRect outlines the w x h rectangle whose bottom left corner is (x, y) with corners rounded to radius r.
+// r is clamped to half the smaller side.
+func (g Geometry) WriteRoundRect(x, y, w, h, r int, buf []byte) []byte {
+	if w < 1 || h < 1 {
+		return buf
+	}
+	r = clampRadius(w, h, r)
+	right, top := x+w-1, y+h-1
+	buf = g.WriteLine(x+r, y, right-r, y, buf)
+	buf = g.WriteLine(x+r, top, right-r, top, buf)
+	buf = g.WriteLine(x, y+r, x, top-r, buf)
+	buf = g.WriteLine(right, y+r, right, top-r, buf)
+	buf = g.writeCircleQuadrants(right-r, top-r, r, 1, buf)
+	buf = g.writeCircleQuadrants(x+r, top-r, r, 2, buf)
+	buf = g.writeCircleQuadrants(x+r, y+r, r, 4, buf)
+	return g.writeCircleQuadrants(right-r, y+r, r, 8, buf)
+}
+
+// WriteFillRoundRect fills the rounded rectangle WriteRoundRect outlines.
+func (g Geometry) WriteFillRoundRect(x, y, w, h, r int, buf []byte) []byte {
+	if w < 1 || h < 1 {
+		return buf
+	}
+	r = clampRadius(w, h, r)
+	right, top := x+w-1, y+h-1
+	buf = g.WriteFillRect(x+r, y, w-2*r, h, buf)
+	// the columns either side of that, stretched between the lower and upper corners' curves
+	f := 1 - r
+	ddx := 1
+	ddy := -2 * r
+	cx, cy := 0, r
+	for cx < cy {
+		if f >= 0 {
+			cy--
+			ddy += 2
+			f += ddy
+		}
+		cx++
+		ddx += 2
+		f += ddx
+		buf = g.WriteLine(x+r-cx, y+r-cy, x+r-cx, top-r+cy, buf)
+		buf = g.WriteLine(x+r-cy, y+r-cx, x+r-cy, top-r+cx, buf)
+		buf = g.WriteLine(right-r+cx, y+r-cy, right-r+cx, top-r+cy, buf)
+		buf = g.WriteLine(right-r+cy, y+r-cx, right-r+cy, top-r+cx, buf)
+	}
+	return g.WriteRoundRect(x, y, w, h, r, buf)
+}
+
+// clampRadius limits a corner radius to what fits a w x h rectangle.
+func clampRadius(w, h, r int) int {
+	maxR := w / 2
+	if h < w {
+		maxR = h / 2
+	}
+	if r > maxR {
+		r = maxR
+	}
+	if r < 0 {
+		r = 0
+	}
+	return r
+}
+
+// WriteProgressBarLabeled draws a w x h progress bar with rounded ends at (x, y), filled from the left to percent
+// (clamped to 0-100), with label centered on it. An empty label shows the percentage. Where the label overlaps the
+// filled part its pixels are knocked out of the fill, so it reads on either side of the edge, and a label too wide for
+// the bar is cut off at the outline.
+func (g Geometry) WriteProgressBarLabeled(x, y, w, h, percent int, label string, buf []byte) []byte {
+	if percent < 0 {
+		percent = 0
+	}
+	if percent > 100 {
+		percent = 100
+	}
+	if label == "" {
+		label = fmt.Sprintf("%d%%", percent)
+	}
+	// the outline and a one pixel gap take 2 pixels on each side
+	fillW := (w - 4) * percent / 100
+
+	// the fill's corners follow the outline's, 2 pixels in
+	r := clampRadius(w, h, h/4)
+	fillR := r - 2
+	if fillR < 0 {
+		fillR = 0
+	}
+	buf = g.setRect(x, y, w, h, false, buf)
+	buf = g.WriteRoundRect(x, y, w, h, r, buf)
+	fill := g.WriteFillRoundRect(x+2, y+2, fillW, h-4, fillR, g.Blank())
+	inside := g.WriteFillRoundRect(x+1, y+1, w-2, h-2, r-1, g.Blank())
+	text := g.WriteString(x+(w-g.textWidth(label))/2, y+(h-g.font().ascent())/2, label, g.Blank())
+	for i := range buf {
+		buf[i] |= fill[i] ^ (text[i] & inside[i])
+	}
+	return buf
+}
+
+// axisTickLen is how far tick marks stick out from the axes.
+const axisTickLen = 3
+
+// WriteAxes draws chart axes along the bottom and left edges of the w x h region at (x, y), each with ticks evenly
+// spaced tick marks pointing out of the region, the last one at the far end. xLabel is centered below the x axis and
+// yLabel reads upwards to the left of the y axis, so the region needs a margin of about 35 pixels below and left of it
+// for them. Empty labels are left out.
+func (g Geometry) WriteAxes(x, y, w, h int, xLabel, yLabel string, ticks int, buf []byte) []byte {
+	buf = g.WriteLine(x, y, x+w-1, y, buf)
+	buf = g.WriteLine(x, y, x, y+h-1, buf)
+	for i := 1; i <= ticks; i++ {
+		tx := x + i*(w-1)/ticks
+		buf = g.WriteLine(tx, y-1, tx, y-axisTickLen, buf)
+		ty := y + i*(h-1)/ticks
+		buf = g.WriteLine(x-1, ty, x-axisTickLen, ty, buf)
+	}
+	// leave a blank row or column between the ticks and the labels
+	if xLabel != "" {
+		buf = g.WriteString(x+(w-g.textWidth(xLabel))/2, y-axisTickLen-2-g.font().ascent(), xLabel, buf)
+	}
+	if yLabel != "" {
+		// rotated a quarter turn the glyphs' tops face left and their descenders right, towards the axis
+		buf = g.WriteStringRotated(x-axisTickLen-2-g.font().descent(), y+(h-g.textWidth(yLabel))/2, 90, yLabel, buf)
+	}
+	return buf
+}
+
+// dpadArms maps the directions a D-pad can have pressed to which way that arm lies from the center, in squares.
+var dpadArms = map[string][2]int{
+	"up":    {0, 1},
+	"down":  {0, -1},
+	"left":  {-1, 0},
+	"right": {1, 0},
+}
+
+// WriteDPad draws a plus shaped D-pad centered on (cx, cy), made of size x size squares for the center and each arm.
+// The arm named by pressed ("up", "down", "left" or "right") is filled and the rest are outlined, or none is filled
+// if pressed is empty. Neighbouring squares share their edge.
+func (g Geometry) WriteDPad(cx, cy, size int, pressed string, buf []byte) []byte {
+	step := size - 1
+	x0, y0 := cx-size/2, cy-size/2
+	buf = g.setRect(x0, y0, size, size, false, buf)
+	buf = g.WriteRect(x0, y0, size, size, buf)
+	for dir, arm := range dpadArms {
+		x, y := x0+arm[0]*step, y0+arm[1]*step
+		if dir == pressed {
+			buf = g.setRect(x, y, size, size, true, buf)
+			continue
+		}
+		// keep the edge shared with the center, which a pressed arm next to it may have filled over
+		buf = g.setRect(x+1, y+1, size-2, size-2, false, buf)
+		buf = g.WriteRect(x, y, size, size, buf)
+	}
+	return buf
+}
+
+// Dash pattern for dashed borders: dashOn pixels on, then dashOff off, repeating around the rectangle.
+const (
+	dashOn  = 3
+	dashOff = 2
+)
+
+// borderRadius is the corner radius of rounded borders.
+const borderRadius = 3
+
+// borderStyles are the styles WriteBorder draws.
+var borderStyles = map[string]bool{"solid": true, "dashed": true, "double": true, "rounded": true}
+
+// WriteBorder frames the w x h rectangle at (x, y) in one of the border styles: "solid", "dashed", "double" (a second
+// rectangle inside the first with a one pixel gap) or "rounded".
+func (g Geometry) WriteBorder(x, y, w, h int, style string, buf []byte) ([]byte, error) {
+	switch style {
+	case "solid":
+		return g.WriteRect(x, y, w, h, buf), nil
+	case "dashed":
+		return g.writeDashedRect(x, y, w, h, buf), nil
+	case "double":
+		buf = g.WriteRect(x, y, w, h, buf)
+		if w > 4 && h > 4 {
+			buf = g.WriteRect(x+2, y+2, w-4, h-4, buf)
+		}
+		return buf, nil
+	case "rounded":
+		return g.WriteRoundRect(x, y, w, h, borderRadius, buf), nil
+	default:
+		return nil, fmt.Errorf(`unknown border style %q, expected "solid", "dashed", "double" or "rounded"`, style)
+	}
+}
+
+// writeDashedRect outlines the w x h rectangle at (x, y) with dashes, walking the edges counterclockwise from the
+// bottom left corner so the pattern carries on around the corners.
+func (g Geometry) writeDashedRect(x, y, w, h int, buf []byte) []byte {
+	if w < 1 || h < 1 {
+		return buf
+	}
+	right, top := x+w-1, y+h-1
+	var perimeter [][2]int
+	for xx := x; xx < right; xx++ {
+		perimeter = append(perimeter, [2]int{xx, y})
+	}
+	for yy := y; yy < top; yy++ {
+		perimeter = append(perimeter, [2]int{right, yy})
+	}
+	for xx := right; xx > x; xx-- {
+		perimeter = append(perimeter, [2]int{xx, top})
+	}
+	for yy := top; yy > y; yy-- {
+		perimeter = append(perimeter, [2]int{x, yy})
+	}
+	if len(perimeter) == 0 {
+		// a single pixel
+		return g.WritePixel(x, y, buf)
+	}
+	for i, p := range perimeter {
+		if i%(dashOn+dashOff) < dashOn {
+			buf = g.WritePixel(p[0], p[1], buf)
+		}
+	}
+	return buf
+}
+
+// WriteKeyValues writes a two column table of keys and their values, one pair per line from the baseline y down.
+// Keys are left aligned at x and values at a common x colGap pixels right of the widest key.
+func (g Geometry) WriteKeyValues(x, y int, keys, values []string, colGap int, buf []byte) []byte {
+	keyWidth := 0
+	for _, key := range keys {
+		if w := g.textWidth(key); w > keyWidth {
+			keyWidth = w
+		}
+	}
+	for i, key := range keys {
+		row := y - i*g.lineHeight()
+		buf = g.WriteString(x, row, key, buf)
+		buf = g.WriteString(x+keyWidth+colGap, row, values[i], buf)
+	}
+	return buf
+}
@@ -0,0 +1,152 @@
+package display
+
+import (
+	"testing"
+
+	"go.viam.com/test"
+)
+
+// litOutside fails t if any pixel of buf outside the rounded rectangle at (x, y) is on.
+func litOutside(t *testing.T, g Geometry, buf []byte, x, y, w, h, r int) {
+	t.Helper()
+	shape := g.WriteFillRoundRect(x, y, w, h, r, g.Blank())
+	litOnly(t, g, buf, 0, 0, g.Width, g.Height, func(px, py int) bool {
+		return g.Pixel(px, py, buf) && g.Pixel(px, py, shape)
+	})
+}
+
+func TestWriteProgressBarLabeled(t *testing.T) {
+	g := defaultGeometry
+
+	t.Run("fill width", func(t *testing.T) {
+		// half of the 100 pixels inside the outline and its gap, along the middle row
+		buf := g.WriteProgressBarLabeled(0, 0, 104, 30, 50, " ", g.Blank())
+		litOnly(t, g, buf, 0, 15, 104, 1, func(px, py int) bool { return px == 0 || px >= 2 && px < 52 || px == 103 })
+	})
+
+	t.Run("label glyphs", func(t *testing.T) {
+		x, y, w, h := 4, 10, 120, 30
+		text := g.WriteString(x+(w-g.textWidth("AB"))/2, y+(h-g.font().ascent())/2, "AB", g.Blank())
+		inside := g.WriteFillRoundRect(x+2, y+2, w-4, h-4, h/4-2, g.Blank())
+		// on an empty bar the glyphs are drawn, on a full one they're knocked out of the fill
+		empty := g.WriteProgressBarLabeled(x, y, w, h, 0, "AB", g.Blank())
+		full := g.WriteProgressBarLabeled(x, y, w, h, 100, "AB", g.Blank())
+		glyphs := 0
+		for px := x + 2; px < x+w-2; px++ {
+			for py := y + 2; py < y+h-2; py++ {
+				if !g.Pixel(px, py, inside) {
+					continue
+				}
+				test.That(t, g.Pixel(px, py, empty), test.ShouldEqual, g.Pixel(px, py, text))
+				test.That(t, g.Pixel(px, py, full), test.ShouldEqual, !g.Pixel(px, py, text))
+				if g.Pixel(px, py, text) {
+					glyphs++
+				}
+			}
+		}
+		test.That(t, glyphs, test.ShouldBeGreaterThan, 0)
+	})
+
+	t.Run("stays inside the outline", func(t *testing.T) {
+		// a tall bar, whose square fill's corners would poke through the rounded outline
+		buf := g.WriteProgressBarLabeled(4, 4, 100, 40, 100, " ", g.Blank())
+		litOutside(t, g, buf, 4, 4, 100, 40, 10)
+		// a label far wider than the bar
+		buf = g.WriteProgressBarLabeled(30, 20, 40, 30, 50, "WWWWWWWW", g.Blank())
+		litOutside(t, g, buf, 30, 20, 40, 30, 7)
+	})
+}
+
+func TestWriteAxes(t *testing.T) {
+	g := defaultGeometry
+	// a 61x31 region at (40, 20) with four ticks on each axis, the last at the far end
+	xTicks := map[int]bool{55: true, 70: true, 85: true, 100: true}
+	yTicks := map[int]bool{27: true, 35: true, 42: true, 50: true}
+	axes := func(px, py int) bool {
+		return py == 20 && px >= 40 && px <= 100 || px == 40 && py >= 20 && py <= 50 ||
+			xTicks[px] && py >= 17 && py < 20 || yTicks[py] && px >= 37 && px < 40
+	}
+	buf := g.WriteAxes(40, 20, 61, 31, "", "", 4, g.Blank())
+	litOnly(t, g, buf, 0, 0, g.Width, g.Height, axes)
+
+	// the labels go below and left of the ticks, leaving the axes and the region as they were
+	buf = g.WriteAxes(40, 20, 61, 31, "t", "v", 4, g.Blank())
+	litOnly(t, g, buf, 37, 17, 64, 34, axes)
+	below := g.Region(40, 0, 61, 16, buf)
+	left := g.Region(0, 20, 36, 31, buf)
+	test.That(t, below, test.ShouldNotResemble, make([]byte, len(below)))
+	test.That(t, left, test.ShouldNotResemble, make([]byte, len(left)))
+}
+
+func TestWriteDPad(t *testing.T) {
+	g := defaultGeometry
+	// 9x9 squares centered on (64, 32), so the center square's corner is (60, 28) and the arms are 8 pixels over
+	idle := g.WriteDPad(64, 32, 9, "", g.Blank())
+	for dir, arm := range dpadArms {
+		x, y := 60+arm[0]*8, 28+arm[1]*8
+		interior := func(px, py int) bool { return px > x && px < x+8 && py > y && py < y+8 }
+		// unpressed, every arm is an outline
+		test.That(t, g.Pixel(x, y, idle), test.ShouldBeTrue)
+		test.That(t, g.Pixel(x+4, y+4, idle), test.ShouldBeFalse)
+
+		pressed := g.WriteDPad(64, 32, 9, dir, g.Blank())
+		litOnly(t, g, pressed, 0, 0, g.Width, g.Height, func(px, py int) bool {
+			// the pressed arm's inside is lit where the idle pad's is dark, and nothing else changes
+			if interior(px, py) {
+				return !g.Pixel(px, py, idle)
+			}
+			return g.Pixel(px, py, idle)
+		})
+	}
+}
+
+func TestWriteBorderDouble(t *testing.T) {
+	g := defaultGeometry
+	// two nested rectangles, the inner one 2 pixels in so there's a blank pixel between them
+	buf, err := g.WriteBorder(10, 5, 30, 20, "double", g.Blank())
+	test.That(t, err, test.ShouldBeNil)
+	onRect := func(px, py, x, y, w, h int) bool {
+		in := px >= x && px < x+w && py >= y && py < y+h
+		return in && (px == x || px == x+w-1 || py == y || py == y+h-1)
+	}
+	litOnly(t, g, buf, 0, 0, g.Width, g.Height, func(px, py int) bool {
+		return onRect(px, py, 10, 5, 30, 20) || onRect(px, py, 12, 7, 26, 16)
+	})
+
+	// with no room for the gap it falls back to the one rectangle
+	buf, err = g.WriteBorder(10, 5, 4, 20, "double", g.Blank())
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, buf, test.ShouldResemble, g.WriteRect(10, 5, 4, 20, g.Blank()))
+}
+
+func TestWriteKeyValues(t *testing.T) {
+	g := defaultGeometry
+	keys, values := []string{"id", "temp"}, []string{"7", "21"}
+	buf := g.WriteKeyValues(2, 45, keys, values, 4, g.Blank())
+
+	// both values start 4 pixels past the wider key, whichever key is on their line
+	valueX := 2 + g.textWidth("temp") + 4
+	want := g.WriteString(2, 45, "id", g.Blank())
+	want = g.WriteString(valueX, 45, "7", want)
+	want = g.WriteString(2, 45-g.lineHeight(), "temp", want)
+	want = g.WriteString(valueX, 45-g.lineHeight(), "21", want)
+	test.That(t, buf, test.ShouldResemble, want)
+	test.That(t, valueX, test.ShouldBeGreaterThan, 2+g.textWidth("id")+4)
+}
+
+func TestWriteFillTriangle(t *testing.T) {
+	g := defaultGeometry
+	// a right triangle with 5 pixel legs along x = 10 and y = 10, its corners given in no particular order
+	inside := func(px, py int) bool { return px >= 10 && py >= 10 && (px-10)+(py-10) <= 4 }
+	buf := g.WriteFillTriangle(14, 10, 10, 14, 10, 10, g.Blank())
+	litOnly(t, g, buf, 0, 0, 30, 30, inside)
+	// and its outline is the two legs and the diagonal between their ends
+	buf = g.WriteTriangle(14, 10, 10, 14, 10, 10, g.Blank())
+	litOnly(t, g, buf, 0, 0, 30, 30, func(px, py int) bool {
+		return inside(px, py) && (px == 10 || py == 10 || (px-10)+(py-10) == 4)
+	})
+
+	// corners in a line fill as the line through them
+	buf = g.WriteFillTriangle(0, 0, 8, 8, 4, 4, g.Blank())
+	test.That(t, buf, test.ShouldResemble, g.WriteLine(0, 0, 8, 8, g.Blank()))
+}